@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/component-base/featuregate"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
+)
+
+// NewDiffCommand creates a command comparing the evictions of two policies over the same cluster state
+func NewDiffCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var policyFiles []string
+	var snapshotDir, output string
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares the evictions of two policies over the same cluster state",
+		Long: `Runs a single descheduling cycle of an old and a new policy in the dry run mode over the same cluster state
+and prints the pods only the old policy evicts, the pods only the new policy evicts and the nodes the plugins of
+the two policies classify differently (e.g. underutilized and overutilized nodes of LowNodeUtilization).
+
+The cluster state is listed from the cluster once, or loaded from a snapshot directory written by the snapshot command.
+Each policy runs over its own copy of the cluster state.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(policyFiles) != 2 {
+				return fmt.Errorf("expected the --policy flag twice, the old and the new policy, got %d policies", len(policyFiles))
+			}
+			if output != "" && output != "json" {
+				return fmt.Errorf("unsupported output format %q, only json is supported", output)
+			}
+			if err := s.ApplyFeatureGates(); err != nil {
+				return err
+			}
+			watch.DefaultChanSize = 100000
+			diff, err := descheduler.DiffPolicies(cmd.Context(), s, policyFiles[0], policyFiles[1], snapshotDir)
+			if err != nil {
+				klog.ErrorS(err, "failed to compare the policies")
+				return err
+			}
+			if output == "json" {
+				return diff.Encode(cmd.OutOrStdout())
+			}
+			return printDiff(cmd.OutOrStdout(), diff)
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	s.AddConnectionFlags(flags)
+	s.AddFeatureGatesFlag(flags)
+	flags.StringArrayVar(&policyFiles, "policy", nil, "Policy file to compare, given twice: the old policy first, then the new one.")
+	flags.StringVar(&snapshotDir, "snapshot", "", "Directory with the YAML or JSON files of the objects to run the policies over. The objects are listed from the cluster when not set.")
+	flags.StringVarP(&output, "output", "o", "", "Output format. One of: json. The differences are printed as tables when not set.")
+	runtime.Must(cmd.MarkFlagRequired("policy"))
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+func printDiff(out io.Writer, diff *simulation.PolicyDiff) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "POLICY\tNAMESPACE\tNAME\tNODE\tPROFILE\tPLUGIN")
+	printEntries := func(policy string, entries []plan.Entry) {
+		for _, entry := range entries {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", policy, entry.Namespace, entry.Name, entry.Node, entry.Profile, entry.Plugin)
+		}
+	}
+	printEntries("old", diff.OnlyOld)
+	printEntries("new", diff.OnlyNew)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%d pods evicted only by the old policy, %d only by the new policy, %d by both\n", len(diff.OnlyOld), len(diff.OnlyNew), diff.Both)
+
+	if len(diff.NodeClassification) == 0 {
+		fmt.Fprintln(out, "no node classified differently")
+		return nil
+	}
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tOLD\tNEW")
+	classes := func(classes []string) string {
+		if len(classes) == 0 {
+			return "<none>"
+		}
+		return strings.Join(classes, ",")
+	}
+	for _, node := range diff.NodeClassification {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", node.Node, classes(node.Old), classes(node.New))
+	}
+	return w.Flush()
+}
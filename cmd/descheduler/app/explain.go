@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/component-base/featuregate"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+)
+
+// NewExplainCommand creates a command telling why the policy would or would not evict a pod
+func NewExplainCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "explain NAMESPACE/POD",
+		Short: "Tells why the policy would or would not evict a pod",
+		Long: `Builds the profiles of the policy and prints for the pod the verdicts of the Filter and PreEvictionFilter
+plugins of each profile, whether each enabled Deschedule and Balance plugin selects the pod and why, and whether
+the pod fits each node of the cluster.
+
+Every Deschedule and Balance plugin runs alone in the dry run mode over its own copy of the cluster state.
+The pod is looked up in the default namespace when no namespace is given.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && output != "json" {
+				return fmt.Errorf("unsupported output format %q, only json is supported", output)
+			}
+			namespace, name := "default", args[0]
+			if idx := strings.Index(args[0], "/"); idx >= 0 {
+				namespace, name = args[0][:idx], args[0][idx+1:]
+			}
+			if namespace == "" || name == "" {
+				return fmt.Errorf("invalid pod %q, expected NAMESPACE/POD", args[0])
+			}
+			if err := s.ApplyFeatureGates(); err != nil {
+				return err
+			}
+			watch.DefaultChanSize = 100000
+			explanation, err := descheduler.Explain(cmd.Context(), s, namespace, name)
+			if err != nil {
+				klog.ErrorS(err, "failed to explain the pod", "pod", args[0])
+				return err
+			}
+			if output == "json" {
+				return explanation.Encode(cmd.OutOrStdout())
+			}
+			return printExplanation(cmd.OutOrStdout(), explanation)
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	s.AddClientFlags(flags)
+	flags.StringVarP(&output, "output", "o", "", "Output format. One of: json. The explanation is printed as tables when not set.")
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+func printExplanation(out io.Writer, explanation *simulation.Explanation) error {
+	fmt.Fprintf(out, "Pod %s/%s on node %s\n", explanation.Namespace, explanation.Name, explanation.Node)
+	for _, profile := range explanation.Profiles {
+		fmt.Fprintf(out, "\nProfile %s\n", profile.Profile)
+		if !profile.NodeSelected {
+			fmt.Fprintln(out, "the node of the pod is not selected by the node selector of the profile")
+		}
+		w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "EXTENSION POINT\tPLUGIN\tRESULT\tREASON")
+		printVerdicts := func(extensionPoint string, verdicts []simulation.Verdict) {
+			for _, verdict := range verdicts {
+				result := "allowed"
+				if !verdict.Allowed {
+					result = "rejected"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", extensionPoint, verdict.Plugin, result, strings.Join(verdict.Reasons, "; "))
+			}
+		}
+		printVerdicts("Filter", profile.Filter)
+		printVerdicts("PreEvictionFilter", profile.PreEvictionFilter)
+		for _, plugin := range profile.Plugins {
+			result := "not selected"
+			if plugin.Selected {
+				result = "selected"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", plugin.ExtensionPoint, plugin.Plugin, result, plugin.Reason)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tFITS\tREASON")
+	for _, nodeFit := range explanation.NodeFit {
+		fmt.Fprintf(w, "%s\t%t\t%s\n", nodeFit.Node, nodeFit.Fits, nodeFit.Reason)
+	}
+	return w.Flush()
+}
@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiserver "k8s.io/apiserver/pkg/server"
 	apiserveroptions "k8s.io/apiserver/pkg/server/options"
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 
 	restclient "k8s.io/client-go/rest"
@@ -54,12 +55,17 @@ type DeschedulerServer struct {
 
 	Client            clientset.Interface
 	EventClient       clientset.Interface
+	DynamicClient     dynamic.Interface
 	MetricsClient     metricsclient.Interface
 	PrometheusClient  promapi.Client
 	SecureServing     *apiserveroptions.SecureServingOptionsWithLoopback
 	SecureServingInfo *apiserver.SecureServingInfo
 	DisableMetrics    bool
 	EnableHTTP2       bool
+	// DisablePolicyReload disables reloading the policy when it changes
+	DisablePolicyReload bool
+	// PolicyName is the name of the DeschedulerPolicy object the policy is read from instead of the policy file
+	PolicyName string
 	// FeatureGates enabled by the user
 	FeatureGates map[string]bool
 	// DefaultFeatureGates for internal accessing so unit tests can enable/disable specific features
@@ -107,13 +113,11 @@ func newDefaultComponentConfig() (*componentconfig.DeschedulerConfiguration, err
 // AddFlags adds flags for a specific SchedulerServer to the specified FlagSet
 func (rs *DeschedulerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&rs.DeschedulingInterval, "descheduling-interval", rs.DeschedulingInterval, "Time interval between two consecutive descheduler executions. Setting this value instructs the descheduler to run in a continuous loop at the interval specified.")
-	fs.StringVar(&rs.ClientConnection.Kubeconfig, "kubeconfig", rs.ClientConnection.Kubeconfig, "File with kube configuration. Deprecated, use client-connection-kubeconfig instead.")
-	fs.StringVar(&rs.ClientConnection.Kubeconfig, "client-connection-kubeconfig", rs.ClientConnection.Kubeconfig, "File path to kube configuration for interacting with kubernetes apiserver.")
-	fs.Float32Var(&rs.ClientConnection.QPS, "client-connection-qps", rs.ClientConnection.QPS, "QPS to use for interacting with kubernetes apiserver.")
-	fs.Int32Var(&rs.ClientConnection.Burst, "client-connection-burst", rs.ClientConnection.Burst, "Burst to use for interacting with kubernetes apiserver.")
-	fs.StringVar(&rs.PolicyConfigFile, "policy-config-file", rs.PolicyConfigFile, "File with descheduler policy configuration.")
+	rs.AddClientFlags(fs)
 	fs.BoolVar(&rs.DryRun, "dry-run", rs.DryRun, "Execute descheduler in dry run mode.")
 	fs.BoolVar(&rs.DisableMetrics, "disable-metrics", rs.DisableMetrics, "Disables metrics. The metrics are by default served through https://localhost:10258/metrics. Secure address, resp. port can be changed through --bind-address, resp. --secure-port flags.")
+	fs.BoolVar(&rs.DisablePolicyReload, "disable-policy-reload", rs.DisablePolicyReload, "Disables reloading the policy file, or the DeschedulerPolicy object, when it changes. The policy is by default checked for changes before every descheduling cycle.")
+	fs.StringVar(&rs.PolicyName, "policy-name", rs.PolicyName, "Name of the cluster scoped DeschedulerPolicy object to read the policy from instead of --policy-config-file. The object is checked for changes before every descheduling cycle and its status reports the last cycle.")
 	fs.StringVar(&rs.Tracing.CollectorEndpoint, "otel-collector-endpoint", "", "Set this flag to the OpenTelemetry Collector Service Address")
 	fs.StringVar(&rs.Tracing.TransportCert, "otel-transport-ca-cert", "", "Path of the CA Cert that can be used to generate the client Certificate for establishing secure connection to the OTEL in gRPC mode")
 	fs.StringVar(&rs.Tracing.ServiceName, "otel-service-name", tracing.DefaultServiceName, "OTEL Trace name to be used with the resources")
@@ -121,20 +125,53 @@ func (rs *DeschedulerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.Float64Var(&rs.Tracing.SampleRate, "otel-sample-rate", 1.0, "Sample rate to collect the Traces")
 	fs.BoolVar(&rs.Tracing.FallbackToNoOpProviderOnError, "otel-fallback-no-op-on-error", false, "Fallback to NoOp Tracer in case of error")
 	fs.BoolVar(&rs.EnableHTTP2, "enable-http2", false, "If http/2 should be enabled for the metrics and health check")
-	fs.Var(cliflag.NewMapStringBool(&rs.FeatureGates), "feature-gates", "A set of key=value pairs that describe feature gates for alpha/experimental features. "+
-		"Options are:\n"+strings.Join(features.DefaultMutableFeatureGate.KnownFeatures(), "\n"))
 
 	componentbaseoptions.BindLeaderElectionFlags(&rs.LeaderElection, fs)
 
 	rs.SecureServing.AddFlags(fs)
 }
 
-func (rs *DeschedulerServer) Apply() error {
-	err := features.DefaultMutableFeatureGate.SetFromMap(rs.FeatureGates)
-	if err != nil {
+// AddClientFlags adds the flags configuring the connection to the cluster, the policy and the feature gates.
+// Commands which do not run the descheduler server (e.g. plan and apply) add these flags only.
+func (rs *DeschedulerServer) AddClientFlags(fs *pflag.FlagSet) {
+	rs.AddConnectionFlags(fs)
+	rs.AddPolicyFlags(fs)
+}
+
+// AddConnectionFlags adds the flags configuring the connection to the cluster
+func (rs *DeschedulerServer) AddConnectionFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&rs.ClientConnection.Kubeconfig, "kubeconfig", rs.ClientConnection.Kubeconfig, "File with kube configuration. Deprecated, use client-connection-kubeconfig instead.")
+	fs.StringVar(&rs.ClientConnection.Kubeconfig, "client-connection-kubeconfig", rs.ClientConnection.Kubeconfig, "File path to kube configuration for interacting with kubernetes apiserver.")
+	fs.Float32Var(&rs.ClientConnection.QPS, "client-connection-qps", rs.ClientConnection.QPS, "QPS to use for interacting with kubernetes apiserver.")
+	fs.Int32Var(&rs.ClientConnection.Burst, "client-connection-burst", rs.ClientConnection.Burst, "Burst to use for interacting with kubernetes apiserver.")
+}
+
+// AddPolicyFlags adds the flags configuring the policy and the feature gates.
+// Commands which do not connect to a cluster (e.g. simulate) add these flags only.
+func (rs *DeschedulerServer) AddPolicyFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&rs.PolicyConfigFile, "policy-config-file", rs.PolicyConfigFile, "File with descheduler policy configuration.")
+	rs.AddFeatureGatesFlag(fs)
+}
+
+// AddFeatureGatesFlag adds the flag configuring the feature gates
+func (rs *DeschedulerServer) AddFeatureGatesFlag(fs *pflag.FlagSet) {
+	fs.Var(cliflag.NewMapStringBool(&rs.FeatureGates), "feature-gates", "A set of key=value pairs that describe feature gates for alpha/experimental features. "+
+		"Options are:\n"+strings.Join(features.DefaultMutableFeatureGate.KnownFeatures(), "\n"))
+}
+
+// ApplyFeatureGates enables the feature gates set by the user
+func (rs *DeschedulerServer) ApplyFeatureGates() error {
+	if err := features.DefaultMutableFeatureGate.SetFromMap(rs.FeatureGates); err != nil {
 		return err
 	}
 	rs.DefaultFeatureGates = features.DefaultMutableFeatureGate
+	return nil
+}
+
+func (rs *DeschedulerServer) Apply() error {
+	if err := rs.ApplyFeatureGates(); err != nil {
+		return err
+	}
 
 	// loopbackClientConfig is a config for a privileged loopback connection
 	var loopbackClientConfig *restclient.Config
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/component-base/logs"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
+)
+
+// NewPlanCommand creates a command computing the evictions of a descheduling cycle into a plan file
+func NewPlanCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Computes the evictions of a descheduling cycle without evicting",
+		Long: `Runs a single descheduling cycle of all the profiles in the dry run mode and writes the evictions
+as a plan file. The plan can be reviewed and executed later by the apply command.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := s.ApplyFeatureGates(); err != nil {
+				return err
+			}
+			// increase the fake watch channel so the dry-run mode can be run
+			// over a cluster with thousands of pods
+			watch.DefaultChanSize = 100000
+			file, err := descheduler.GeneratePlan(cmd.Context(), s)
+			if err != nil {
+				klog.ErrorS(err, "failed to compute the plan")
+				return err
+			}
+			if output == "" {
+				return file.Encode(cmd.OutOrStdout())
+			}
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := file.Encode(f); err != nil {
+				return fmt.Errorf("unable to write the plan to %s: %v", output, err)
+			}
+			klog.InfoS("Plan written", "file", output, "evictions", len(file.Entries))
+			return nil
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	s.AddClientFlags(flags)
+	flags.StringVarP(&output, "output", "o", "", "File to write the plan to. The plan is written to the standard output when not set.")
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+// NewApplyCommand creates a command executing the evictions of a plan file which are still valid
+func NewApplyCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var planFile string
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Executes the evictions of a plan",
+		Long: `Executes the evictions of a plan written by the plan command. Each eviction is checked against
+the current state of the cluster first: the pod must still exist with the same UID on the same node
+and the profile of the policy must still consider it evictable. Evictions which are no longer valid are skipped.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := s.ApplyFeatureGates(); err != nil {
+				return err
+			}
+			f, err := os.Open(planFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			file, err := plan.DecodeFile(f)
+			if err != nil {
+				return err
+			}
+			results, err := descheduler.ApplyPlan(cmd.Context(), s, file)
+			if err != nil {
+				klog.ErrorS(err, "failed to apply the plan")
+				return err
+			}
+			evicted := 0
+			for _, result := range results {
+				if result.Err != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), "skipped %s/%s: %v\n", result.Entry.Namespace, result.Entry.Name, result.Err)
+					continue
+				}
+				evicted++
+				fmt.Fprintf(cmd.OutOrStdout(), "evicted %s/%s\n", result.Entry.Namespace, result.Entry.Name)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%d of %d planned evictions applied\n", evicted, len(results))
+			return nil
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	s.AddClientFlags(flags)
+	flags.StringVar(&planFile, "plan", "", "Plan file written by the plan command.")
+	runtime.Must(cmd.MarkFlagRequired("plan"))
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+func subcommandPreRun(logConfig *logsapi.LoggingConfiguration, featureGate featuregate.MutableFeatureGate) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logs.InitLogs()
+		if err := logsapi.ValidateAndApply(logConfig, featureGate); err != nil {
+			return err
+		}
+		descheduler.SetupPlugins()
+		return nil
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+// NewValidateCommand creates a command validating a policy file without connecting to a cluster
+func NewValidateCommand(out io.Writer) *cobra.Command {
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var policyFile string
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates a policy file without connecting to a cluster",
+		Long: `Decodes the policy file strictly, rejecting unknown and duplicated fields, and validates the policy
+and the arguments of all its plugins. Every error is printed with the path of the field it is found at.
+The command exits with an error when the policy is invalid.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			errs := descheduler.ValidatePolicyConfig(policyFile, pluginregistry.PluginRegistry)
+			if len(errs) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s is valid\n", policyFile)
+				return nil
+			}
+			for _, err := range errs {
+				var policyErr *descheduler.PolicyError
+				if errors.As(err, &policyErr) {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: %v\n", policyErr.Field, policyErr.Err)
+				} else {
+					fmt.Fprintln(cmd.OutOrStdout(), err)
+				}
+			}
+			return fmt.Errorf("%s is invalid, %d errors found", policyFile, len(errs))
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	flags.StringVarP(&policyFile, "filename", "f", "", "Policy file to validate.")
+	runtime.Must(cmd.MarkFlagRequired("filename"))
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+// NewRenderCommand creates a command printing a policy file with all the defaults set
+func NewRenderCommand(out io.Writer) *cobra.Command {
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var policyFile, output string
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Prints a policy file with all the defaults set",
+		Long: `Loads and validates the policy file and prints the v1alpha2 policy with all the defaults set, the defaults
+of the arguments of the plugins and the DefaultEvictor plugin enabled in every profile included.
+No cluster is needed, a priority threshold given by a priority class name is printed as is.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "yaml" && output != "json" {
+				return fmt.Errorf("unsupported output format %q, one of yaml and json is supported", output)
+			}
+			policy, err := descheduler.RenderPolicyConfig(policyFile, pluginregistry.PluginRegistry)
+			if err != nil {
+				return err
+			}
+			var data []byte
+			if output == "json" {
+				data, err = json.MarshalIndent(policy, "", "  ")
+				data = append(data, '\n')
+			} else {
+				data, err = yaml.Marshal(policy)
+			}
+			if err != nil {
+				return fmt.Errorf("unable to encode the policy: %v", err)
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	flags.StringVarP(&policyFile, "filename", "f", "", "Policy file to render.")
+	flags.StringVarP(&output, "output", "o", "yaml", "Output format. One of: yaml, json.")
+	runtime.Must(cmd.MarkFlagRequired("filename"))
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+// NewSchemaCommand creates a command printing the schema of the policy
+func NewSchemaCommand(out io.Writer) *cobra.Command {
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var format, output string
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Prints the schema of the policy",
+		Long: `Prints the JSON Schema of the v1alpha2 policy, or an OpenAPI v3 document with the schema in its components.
+The arguments of a plugin config are described by a oneOf keyed by the name of the plugin, built from the
+argument types of all the plugins of this build, so editors and CI can validate the policies against it.
+The crd format prints the definition of the DeschedulerPolicy custom resource instead.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "yaml" && output != "json" {
+				return fmt.Errorf("unsupported output format %q, one of yaml and json is supported", output)
+			}
+			var document interface{}
+			switch format {
+			case "jsonschema":
+				document = descheduler.PolicySchema(pluginregistry.PluginRegistry)
+			case "openapi":
+				document = descheduler.PolicyOpenAPI(pluginregistry.PluginRegistry)
+			case "crd":
+				crd, err := descheduler.PolicyCustomResourceDefinition(pluginregistry.PluginRegistry)
+				if err != nil {
+					return fmt.Errorf("unable to build the custom resource definition: %v", err)
+				}
+				manifest, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(crd)
+				if err != nil {
+					return fmt.Errorf("unable to build the custom resource definition: %v", err)
+				}
+				// the status and the creation timestamp are set by the API server
+				delete(manifest, "status")
+				unstructured.RemoveNestedField(manifest, "metadata", "creationTimestamp")
+				document = manifest
+			default:
+				return fmt.Errorf("unsupported schema format %q, one of jsonschema, openapi and crd is supported", format)
+			}
+			data, err := json.MarshalIndent(document, "", "  ")
+			if err != nil {
+				return fmt.Errorf("unable to encode the schema: %v", err)
+			}
+			if output == "yaml" {
+				if data, err = yaml.JSONToYAML(data); err != nil {
+					return fmt.Errorf("unable to encode the schema: %v", err)
+				}
+			} else {
+				data = append(data, '\n')
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "jsonschema", "Schema format. One of: jsonschema, openapi, crd.")
+	flags.StringVarP(&output, "output", "o", "json", "Output format. One of: json, yaml.")
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
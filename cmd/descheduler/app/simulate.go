@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/component-base/featuregate"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+)
+
+// NewSimulateCommand creates a command running the policy over a snapshot of a cluster
+func NewSimulateCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var snapshotDir, output string
+	cycles := 1
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Computes the evictions of descheduling cycles over a snapshot of a cluster",
+		Long: `Loads the objects of a snapshot directory, i.e. YAML or JSON files with nodes, pods, pod disruption budgets,
+namespaces and priority classes, runs descheduling cycles of all the profiles over them and prints the evictions.
+No cluster is needed. A snapshot of a cluster can be taken by the snapshot command.
+
+Between the cycles each evicted pod with an owner is replaced by a new pod scheduled to the node it fits on best,
+as its controller and the scheduler would do. The simulation stops at the first cycle evicting no pod and reports
+the node utilization after each cycle, whether the evictions converged and the pods evicted more than once.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && output != "json" {
+				return fmt.Errorf("unsupported output format %q, only json is supported", output)
+			}
+			if err := s.ApplyFeatureGates(); err != nil {
+				return err
+			}
+			watch.DefaultChanSize = 100000
+			result, err := descheduler.Simulate(cmd.Context(), s, snapshotDir, cycles)
+			if err != nil {
+				klog.ErrorS(err, "failed to simulate the policy")
+				return err
+			}
+			if output == "json" {
+				return result.Encode(cmd.OutOrStdout())
+			}
+			return printSimulation(cmd.OutOrStdout(), result)
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	s.AddPolicyFlags(flags)
+	flags.StringVar(&snapshotDir, "snapshot", "", "Directory with the YAML or JSON files of the objects to run the policy over.")
+	flags.IntVar(&cycles, "cycles", cycles, "Maximum number of descheduling cycles to simulate.")
+	flags.StringVarP(&output, "output", "o", "", "Output format. One of: json. The evictions are printed as a table when not set.")
+	runtime.Must(cmd.MarkFlagRequired("snapshot"))
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+// NewSnapshotCommand creates a command exporting the objects the policy is run over into a snapshot directory
+func NewSnapshotCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+
+	featureGate := featuregate.NewFeatureGate()
+	logConfig := logsapi.NewLoggingConfiguration()
+	var outputDir string
+
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Exports the objects the descheduler runs the policy over into a snapshot directory",
+		Long: `Exports the nodes, pods, pod disruption budgets, namespaces, priority classes and the other objects
+the plugins of the policy need into YAML files, one file per kind. The snapshot can be run over by the simulate command.`,
+		PreRunE: subcommandPreRun(logConfig, featureGate),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := s.ApplyFeatureGates(); err != nil {
+				return err
+			}
+			if err := descheduler.TakeSnapshot(cmd.Context(), s, outputDir); err != nil {
+				klog.ErrorS(err, "failed to take the snapshot")
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.SetOut(out)
+	flags := cmd.Flags()
+	s.AddClientFlags(flags)
+	flags.StringVarP(&outputDir, "output-dir", "o", "", "Directory to write the snapshot to. The directory is created when it does not exist.")
+	runtime.Must(cmd.MarkFlagRequired("output-dir"))
+
+	runtime.Must(logsapi.AddFeatureGates(featureGate))
+	logsapi.AddFlags(logConfig, flags)
+
+	return cmd
+}
+
+func printSimulation(out io.Writer, result *simulation.Result) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CYCLE\tNAMESPACE\tNAME\tNODE\tPROFILE\tPLUGIN\tTARGET NODE")
+	for idx, cycle := range result.Cycles {
+		for _, entry := range cycle.Evictions {
+			targetNode := entry.TargetNode
+			if targetNode == "" {
+				targetNode = "<none>"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", idx+1, entry.Namespace, entry.Name, entry.Node, entry.Profile, entry.Plugin, targetNode)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CYCLE\tNODE\tCPU\tMEMORY\tPODS")
+	for idx, cycle := range result.Cycles {
+		nodeNames := make([]string, 0, len(cycle.Utilization))
+		for nodeName := range cycle.Utilization {
+			nodeNames = append(nodeNames, nodeName)
+		}
+		sort.Strings(nodeNames)
+		for _, nodeName := range nodeNames {
+			usage := cycle.Utilization[nodeName]
+			fmt.Fprintf(w, "%d\t%s\t%.0f%%\t%.0f%%\t%.0f%%\n", idx+1, nodeName, usage[v1.ResourceCPU], usage[v1.ResourceMemory], usage[v1.ResourcePods])
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	for idx, cycle := range result.Cycles {
+		fmt.Fprintf(out, "cycle %d: %d evictions", idx+1, len(cycle.Evictions))
+		if len(cycle.Unschedulable) > 0 {
+			fmt.Fprintf(out, ", unschedulable replacements: %s", strings.Join(cycle.Unschedulable, ", "))
+		}
+		fmt.Fprintln(out)
+	}
+	if result.Converged {
+		fmt.Fprintf(out, "converged after %d cycles\n", len(result.Cycles))
+	} else {
+		fmt.Fprintf(out, "not converged after %d cycles\n", len(result.Cycles))
+	}
+	for _, moved := range result.MovedPods {
+		fmt.Fprintf(out, "pod %s/%s moved more than once: %s\n", moved.Namespace, moved.Name, strings.Join(moved.Nodes, " -> "))
+	}
+	return nil
+}
@@ -27,6 +27,15 @@ func main() {
 	out := os.Stdout
 	cmd := app.NewDeschedulerCommand(out)
 	cmd.AddCommand(app.NewVersionCommand())
+	cmd.AddCommand(app.NewPlanCommand(out))
+	cmd.AddCommand(app.NewApplyCommand(out))
+	cmd.AddCommand(app.NewSimulateCommand(out))
+	cmd.AddCommand(app.NewSnapshotCommand(out))
+	cmd.AddCommand(app.NewDiffCommand(out))
+	cmd.AddCommand(app.NewExplainCommand(out))
+	cmd.AddCommand(app.NewValidateCommand(out))
+	cmd.AddCommand(app.NewRenderCommand(out))
+	cmd.AddCommand(app.NewSchemaCommand(out))
 
 	code := cli.Run(cmd)
 	os.Exit(code)
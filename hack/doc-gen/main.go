@@ -13,6 +13,15 @@ var docGenPath = "docs/cli"
 func main() {
 	cmd := app.NewDeschedulerCommand(os.Stdout)
 	cmd.AddCommand(app.NewVersionCommand())
+	cmd.AddCommand(app.NewPlanCommand(os.Stdout))
+	cmd.AddCommand(app.NewApplyCommand(os.Stdout))
+	cmd.AddCommand(app.NewSimulateCommand(os.Stdout))
+	cmd.AddCommand(app.NewSnapshotCommand(os.Stdout))
+	cmd.AddCommand(app.NewDiffCommand(os.Stdout))
+	cmd.AddCommand(app.NewExplainCommand(os.Stdout))
+	cmd.AddCommand(app.NewValidateCommand(os.Stdout))
+	cmd.AddCommand(app.NewRenderCommand(os.Stdout))
+	cmd.AddCommand(app.NewSchemaCommand(os.Stdout))
 	cmd.DisableAutoGenTag = true // Disable this so that the diff wont track it
 	if err := doc.GenMarkdownTree(cmd, docGenPath); err != nil {
 		log.Fatal(err)
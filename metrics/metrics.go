@@ -38,6 +38,14 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		}, []string{"result", "strategy", "profile", "namespace", "node"})
 
+	PodsFiltered = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "pods_filtered",
+			Help:           "Number of pods rejected by evictor plugins, by the extension point, by the plugin, by the reason code, by the profile. A pod is counted once per descheduling cycle",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"extension_point", "plugin", "reason", "profile"})
+
 	buildInfo = metrics.NewGauge(
 		&metrics.GaugeOpts{
 			Subsystem:      DeschedulerSubsystem,
@@ -66,11 +74,30 @@ var (
 			Buckets:        []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 25, 50, 100},
 		}, []string{"strategy", "profile"})
 
+	PluginRuns = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "plugin_runs",
+			Help:           "Number of deschedule and balance plugin runs, by the extension point, by the plugin, by the profile, by the status code",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"extension_point", "plugin", "profile", "code"})
+
+	PolicyReloads = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "policy_reloads",
+			Help:           "Number of reloads of the changed policy file, by the result. 'error' result means the policy was rejected and the previous policy kept running",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"result"})
+
 	metricsList = []metrics.Registerable{
 		PodsEvicted,
+		PodsFiltered,
 		buildInfo,
 		DeschedulerLoopDuration,
 		DeschedulerStrategyDuration,
+		PluginRuns,
+		PolicyReloads,
 	}
 )
 
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -59,6 +60,90 @@ type DeschedulerPolicy struct {
 	// specified type will be used.
 	// Defaults to a per object value if not specified. zero means delete immediately.
 	GracePeriodSeconds *int64
+
+	// Concurrency sets the number of workers running profiles, per node work
+	// of node-local plugins and evictions in parallel. Eviction limits are shared
+	// by all the workers and never exceeded.
+	// Defaults to 1, i.e. everything runs sequentially.
+	Concurrency *uint
+
+	// EvictionRateLimits limits the number of evictions within time windows
+	// spanning multiple descheduling cycles, on top of the per cycle limits.
+	EvictionRateLimits *EvictionRateLimits
+
+	// RollingEvictions holds further evictions of pods owned by a ReplicaSet or
+	// a StatefulSet until the ready replicas of the owner recover from the last eviction.
+	RollingEvictions *RollingEvictions
+
+	// MaxUnavailablePerOwner limits the number of pods of a single owner evicted
+	// per a descheduling cycle, either as an absolute number or as a percentage
+	// of the pods of the owner, e.g. 10%. Percentages are rounded up.
+	MaxUnavailablePerOwner *intstr.IntOrString
+
+	// EvictionPlanning enables the plan-then-execute mode. All the plugins of all
+	// the profiles propose their eviction candidates first, the candidates are
+	// ranked and the evictions are executed last.
+	EvictionPlanning *EvictionPlanning
+}
+
+// EvictionPlanning configures the plan-then-execute mode
+type EvictionPlanning struct {
+	// ProfileWeights share the evictions among profiles, e.g. a profile with
+	// weight 2 gets twice as many evictions as a profile with weight 1 before
+	// the eviction limits are reached. Profiles not listed have weight 1.
+	ProfileWeights map[string]uint
+
+	// NamespaceWeights share the evictions of a profile among namespaces.
+	// Namespaces not listed have weight 1.
+	NamespaceWeights map[string]uint
+}
+
+// RollingEvictions configures the rolling eviction mode
+type RollingEvictions struct {
+	// Timeout after which evictions of the owner are no longer held even when
+	// its ready replicas did not recover. Defaults to 10m.
+	Timeout *metav1.Duration
+}
+
+// EvictionRateLimitScope is the scope an eviction rate limit applies to
+type EvictionRateLimitScope string
+
+const (
+	// ClusterRateLimitScope counts the evictions across the whole cluster
+	ClusterRateLimitScope EvictionRateLimitScope = "Cluster"
+	// NamespaceRateLimitScope counts the evictions in each namespace separately
+	NamespaceRateLimitScope EvictionRateLimitScope = "Namespace"
+)
+
+// EvictionRateLimits configures the eviction rate limits
+type EvictionRateLimits struct {
+	// Limits are all enforced, e.g. 50 evictions per hour in the cluster
+	// and 20 evictions per day in each namespace.
+	Limits []EvictionRateLimit
+
+	// StateConfigMap persists the past evictions so restarts and leader
+	// changes do not reset the limits. The state is kept in memory only when not set.
+	StateConfigMap *ConfigMapReference
+}
+
+// EvictionRateLimit limits the number of evictions within a sliding time window
+type EvictionRateLimit struct {
+	// Scope of the limit, defaults to Cluster.
+	Scope EvictionRateLimitScope
+
+	// MaxEvictions is the maximum number of evictions within the window
+	MaxEvictions uint
+
+	// Window is the length of the sliding time window, e.g. 1h
+	Window metav1.Duration
+}
+
+// ConfigMapReference holds a reference to a ConfigMap
+type ConfigMapReference struct {
+	// namespace is the namespace of the config map.
+	Namespace string
+	// name is the name of the config map.
+	Name string
 }
 
 // Namespaces carries a list of included/excluded namespaces
@@ -88,6 +173,44 @@ type DeschedulerProfile struct {
 	Name          string
 	PluginConfigs []PluginConfig
 	Plugins       Plugins
+
+	// NodeSelector limits the nodes the profile operates over.
+	// Applied on top of the global node selector.
+	NodeSelector *string
+
+	// MaxNoOfPodsToEvictPerNode restricts maximum of pods to be evicted per node by the profile.
+	// Enforced on top of the global limit.
+	MaxNoOfPodsToEvictPerNode *uint
+
+	// MaxNoOfPodsToEvictPerNamespace restricts maximum of pods to be evicted per namespace by the profile.
+	// Enforced on top of the global limit.
+	MaxNoOfPodsToEvictPerNamespace *uint
+
+	// MaxNoOfPodsToEvictTotal restricts maximum of pods to be evicted total by the profile.
+	// Enforced on top of the global limit.
+	MaxNoOfPodsToEvictTotal *uint
+
+	// GracePeriodSeconds overrides the global grace period for evictions made by the profile.
+	GracePeriodSeconds *int64
+
+	// Schedule runs the profile on its own schedule instead of every descheduling interval.
+	Schedule *ProfileSchedule
+}
+
+// ProfileSchedule configures when a profile runs.
+// Exactly one of Cron and Interval is expected to be set.
+type ProfileSchedule struct {
+	// Cron is a standard five field cron expression, e.g. "0 2 * * *".
+	Cron string
+
+	// TimeZone the cron expression is evaluated in, e.g. "Europe/Prague". Defaults to UTC.
+	TimeZone *string
+
+	// Interval runs the profile every interval, starting right away.
+	Interval *metav1.Duration
+
+	// Jitter delays every run by a random duration up to the jitter.
+	Jitter *metav1.Duration
 }
 
 type PluginConfig struct {
@@ -102,6 +225,7 @@ type Plugins struct {
 	Balance           PluginSet
 	Filter            PluginSet
 	PreEvictionFilter PluginSet
+	PostEviction      PluginSet
 }
 
 type PluginSet struct {
@@ -24,8 +24,10 @@ package v1alpha2
 import (
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	api "sigs.k8s.io/descheduler/pkg/api"
 )
 
@@ -46,6 +48,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConfigMapReference)(nil), (*api.ConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ConfigMapReference_To_api_ConfigMapReference(a.(*ConfigMapReference), b.(*api.ConfigMapReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.ConfigMapReference)(nil), (*ConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_ConfigMapReference_To_v1alpha2_ConfigMapReference(a.(*api.ConfigMapReference), b.(*ConfigMapReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeschedulerProfile)(nil), (*api.DeschedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_DeschedulerProfile_To_api_DeschedulerProfile(a.(*DeschedulerProfile), b.(*api.DeschedulerProfile), scope)
 	}); err != nil {
@@ -56,6 +68,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EvictionPlanning)(nil), (*api.EvictionPlanning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionPlanning_To_api_EvictionPlanning(a.(*EvictionPlanning), b.(*api.EvictionPlanning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionPlanning)(nil), (*EvictionPlanning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionPlanning_To_v1alpha2_EvictionPlanning(a.(*api.EvictionPlanning), b.(*EvictionPlanning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionRateLimit)(nil), (*api.EvictionRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(a.(*EvictionRateLimit), b.(*api.EvictionRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionRateLimit)(nil), (*EvictionRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(a.(*api.EvictionRateLimit), b.(*EvictionRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionRateLimits)(nil), (*api.EvictionRateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(a.(*EvictionRateLimits), b.(*api.EvictionRateLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionRateLimits)(nil), (*EvictionRateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(a.(*api.EvictionRateLimits), b.(*EvictionRateLimits), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MetricsCollector)(nil), (*api.MetricsCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_MetricsCollector_To_api_MetricsCollector(a.(*MetricsCollector), b.(*api.MetricsCollector), scope)
 	}); err != nil {
@@ -101,6 +143,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProfileSchedule)(nil), (*api.ProfileSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ProfileSchedule_To_api_ProfileSchedule(a.(*ProfileSchedule), b.(*api.ProfileSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.ProfileSchedule)(nil), (*ProfileSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_ProfileSchedule_To_v1alpha2_ProfileSchedule(a.(*api.ProfileSchedule), b.(*ProfileSchedule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Prometheus)(nil), (*api.Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Prometheus_To_api_Prometheus(a.(*Prometheus), b.(*api.Prometheus), scope)
 	}); err != nil {
@@ -111,6 +163,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollingEvictions)(nil), (*api.RollingEvictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_RollingEvictions_To_api_RollingEvictions(a.(*RollingEvictions), b.(*api.RollingEvictions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.RollingEvictions)(nil), (*RollingEvictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_RollingEvictions_To_v1alpha2_RollingEvictions(a.(*api.RollingEvictions), b.(*RollingEvictions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretReference)(nil), (*api.SecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_SecretReference_To_api_SecretReference(a.(*SecretReference), b.(*api.SecretReference), scope)
 	}); err != nil {
@@ -159,6 +221,28 @@ func Convert_api_AuthToken_To_v1alpha2_AuthToken(in *api.AuthToken, out *AuthTok
 	return autoConvert_api_AuthToken_To_v1alpha2_AuthToken(in, out, s)
 }
 
+func autoConvert_v1alpha2_ConfigMapReference_To_api_ConfigMapReference(in *ConfigMapReference, out *api.ConfigMapReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha2_ConfigMapReference_To_api_ConfigMapReference is an autogenerated conversion function.
+func Convert_v1alpha2_ConfigMapReference_To_api_ConfigMapReference(in *ConfigMapReference, out *api.ConfigMapReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ConfigMapReference_To_api_ConfigMapReference(in, out, s)
+}
+
+func autoConvert_api_ConfigMapReference_To_v1alpha2_ConfigMapReference(in *api.ConfigMapReference, out *ConfigMapReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_api_ConfigMapReference_To_v1alpha2_ConfigMapReference is an autogenerated conversion function.
+func Convert_api_ConfigMapReference_To_v1alpha2_ConfigMapReference(in *api.ConfigMapReference, out *ConfigMapReference, s conversion.Scope) error {
+	return autoConvert_api_ConfigMapReference_To_v1alpha2_ConfigMapReference(in, out, s)
+}
+
 func autoConvert_v1alpha2_DeschedulerPolicy_To_api_DeschedulerPolicy(in *DeschedulerPolicy, out *api.DeschedulerPolicy, s conversion.Scope) error {
 	if in.Profiles != nil {
 		in, out := &in.Profiles, &out.Profiles
@@ -179,6 +263,11 @@ func autoConvert_v1alpha2_DeschedulerPolicy_To_api_DeschedulerPolicy(in *Desched
 	out.MetricsCollector = (*api.MetricsCollector)(unsafe.Pointer(in.MetricsCollector))
 	out.MetricsProviders = *(*[]api.MetricsProvider)(unsafe.Pointer(&in.MetricsProviders))
 	out.GracePeriodSeconds = (*int64)(unsafe.Pointer(in.GracePeriodSeconds))
+	out.Concurrency = (*uint)(unsafe.Pointer(in.Concurrency))
+	out.EvictionRateLimits = (*api.EvictionRateLimits)(unsafe.Pointer(in.EvictionRateLimits))
+	out.RollingEvictions = (*api.RollingEvictions)(unsafe.Pointer(in.RollingEvictions))
+	out.MaxUnavailablePerOwner = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailablePerOwner))
+	out.EvictionPlanning = (*api.EvictionPlanning)(unsafe.Pointer(in.EvictionPlanning))
 	return nil
 }
 
@@ -202,6 +291,11 @@ func autoConvert_api_DeschedulerPolicy_To_v1alpha2_DeschedulerPolicy(in *api.Des
 	out.MetricsCollector = (*MetricsCollector)(unsafe.Pointer(in.MetricsCollector))
 	out.MetricsProviders = *(*[]MetricsProvider)(unsafe.Pointer(&in.MetricsProviders))
 	out.GracePeriodSeconds = (*int64)(unsafe.Pointer(in.GracePeriodSeconds))
+	out.Concurrency = (*uint)(unsafe.Pointer(in.Concurrency))
+	out.EvictionRateLimits = (*EvictionRateLimits)(unsafe.Pointer(in.EvictionRateLimits))
+	out.RollingEvictions = (*RollingEvictions)(unsafe.Pointer(in.RollingEvictions))
+	out.MaxUnavailablePerOwner = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailablePerOwner))
+	out.EvictionPlanning = (*EvictionPlanning)(unsafe.Pointer(in.EvictionPlanning))
 	return nil
 }
 
@@ -221,6 +315,12 @@ func autoConvert_v1alpha2_DeschedulerProfile_To_api_DeschedulerProfile(in *Desch
 	if err := Convert_v1alpha2_Plugins_To_api_Plugins(&in.Plugins, &out.Plugins, s); err != nil {
 		return err
 	}
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.MaxNoOfPodsToEvictPerNode = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNode))
+	out.MaxNoOfPodsToEvictPerNamespace = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNamespace))
+	out.MaxNoOfPodsToEvictTotal = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictTotal))
+	out.GracePeriodSeconds = (*int64)(unsafe.Pointer(in.GracePeriodSeconds))
+	out.Schedule = (*api.ProfileSchedule)(unsafe.Pointer(in.Schedule))
 	return nil
 }
 
@@ -245,6 +345,12 @@ func autoConvert_api_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in *api.D
 	if err := Convert_api_Plugins_To_v1alpha2_Plugins(&in.Plugins, &out.Plugins, s); err != nil {
 		return err
 	}
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.MaxNoOfPodsToEvictPerNode = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNode))
+	out.MaxNoOfPodsToEvictPerNamespace = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNamespace))
+	out.MaxNoOfPodsToEvictTotal = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictTotal))
+	out.GracePeriodSeconds = (*int64)(unsafe.Pointer(in.GracePeriodSeconds))
+	out.Schedule = (*ProfileSchedule)(unsafe.Pointer(in.Schedule))
 	return nil
 }
 
@@ -253,6 +359,74 @@ func Convert_api_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in *api.Desch
 	return autoConvert_api_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in, out, s)
 }
 
+func autoConvert_v1alpha2_EvictionPlanning_To_api_EvictionPlanning(in *EvictionPlanning, out *api.EvictionPlanning, s conversion.Scope) error {
+	out.ProfileWeights = *(*map[string]uint)(unsafe.Pointer(&in.ProfileWeights))
+	out.NamespaceWeights = *(*map[string]uint)(unsafe.Pointer(&in.NamespaceWeights))
+	return nil
+}
+
+// Convert_v1alpha2_EvictionPlanning_To_api_EvictionPlanning is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionPlanning_To_api_EvictionPlanning(in *EvictionPlanning, out *api.EvictionPlanning, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionPlanning_To_api_EvictionPlanning(in, out, s)
+}
+
+func autoConvert_api_EvictionPlanning_To_v1alpha2_EvictionPlanning(in *api.EvictionPlanning, out *EvictionPlanning, s conversion.Scope) error {
+	out.ProfileWeights = *(*map[string]uint)(unsafe.Pointer(&in.ProfileWeights))
+	out.NamespaceWeights = *(*map[string]uint)(unsafe.Pointer(&in.NamespaceWeights))
+	return nil
+}
+
+// Convert_api_EvictionPlanning_To_v1alpha2_EvictionPlanning is an autogenerated conversion function.
+func Convert_api_EvictionPlanning_To_v1alpha2_EvictionPlanning(in *api.EvictionPlanning, out *EvictionPlanning, s conversion.Scope) error {
+	return autoConvert_api_EvictionPlanning_To_v1alpha2_EvictionPlanning(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(in *EvictionRateLimit, out *api.EvictionRateLimit, s conversion.Scope) error {
+	out.Scope = api.EvictionRateLimitScope(in.Scope)
+	out.MaxEvictions = in.MaxEvictions
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(in *EvictionRateLimit, out *api.EvictionRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(in, out, s)
+}
+
+func autoConvert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(in *api.EvictionRateLimit, out *EvictionRateLimit, s conversion.Scope) error {
+	out.Scope = EvictionRateLimitScope(in.Scope)
+	out.MaxEvictions = in.MaxEvictions
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit is an autogenerated conversion function.
+func Convert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(in *api.EvictionRateLimit, out *EvictionRateLimit, s conversion.Scope) error {
+	return autoConvert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(in *EvictionRateLimits, out *api.EvictionRateLimits, s conversion.Scope) error {
+	out.Limits = *(*[]api.EvictionRateLimit)(unsafe.Pointer(&in.Limits))
+	out.StateConfigMap = (*api.ConfigMapReference)(unsafe.Pointer(in.StateConfigMap))
+	return nil
+}
+
+// Convert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(in *EvictionRateLimits, out *api.EvictionRateLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(in, out, s)
+}
+
+func autoConvert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(in *api.EvictionRateLimits, out *EvictionRateLimits, s conversion.Scope) error {
+	out.Limits = *(*[]EvictionRateLimit)(unsafe.Pointer(&in.Limits))
+	out.StateConfigMap = (*ConfigMapReference)(unsafe.Pointer(in.StateConfigMap))
+	return nil
+}
+
+// Convert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits is an autogenerated conversion function.
+func Convert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(in *api.EvictionRateLimits, out *EvictionRateLimits, s conversion.Scope) error {
+	return autoConvert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(in, out, s)
+}
+
 func autoConvert_v1alpha2_MetricsCollector_To_api_MetricsCollector(in *MetricsCollector, out *api.MetricsCollector, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -357,6 +531,9 @@ func autoConvert_v1alpha2_Plugins_To_api_Plugins(in *Plugins, out *api.Plugins,
 	if err := Convert_v1alpha2_PluginSet_To_api_PluginSet(&in.PreEvictionFilter, &out.PreEvictionFilter, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha2_PluginSet_To_api_PluginSet(&in.PostEviction, &out.PostEviction, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -384,6 +561,9 @@ func autoConvert_api_Plugins_To_v1alpha2_Plugins(in *api.Plugins, out *Plugins,
 	if err := Convert_api_PluginSet_To_v1alpha2_PluginSet(&in.PreEvictionFilter, &out.PreEvictionFilter, s); err != nil {
 		return err
 	}
+	if err := Convert_api_PluginSet_To_v1alpha2_PluginSet(&in.PostEviction, &out.PostEviction, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -392,6 +572,32 @@ func Convert_api_Plugins_To_v1alpha2_Plugins(in *api.Plugins, out *Plugins, s co
 	return autoConvert_api_Plugins_To_v1alpha2_Plugins(in, out, s)
 }
 
+func autoConvert_v1alpha2_ProfileSchedule_To_api_ProfileSchedule(in *ProfileSchedule, out *api.ProfileSchedule, s conversion.Scope) error {
+	out.Cron = in.Cron
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.Jitter = (*v1.Duration)(unsafe.Pointer(in.Jitter))
+	return nil
+}
+
+// Convert_v1alpha2_ProfileSchedule_To_api_ProfileSchedule is an autogenerated conversion function.
+func Convert_v1alpha2_ProfileSchedule_To_api_ProfileSchedule(in *ProfileSchedule, out *api.ProfileSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ProfileSchedule_To_api_ProfileSchedule(in, out, s)
+}
+
+func autoConvert_api_ProfileSchedule_To_v1alpha2_ProfileSchedule(in *api.ProfileSchedule, out *ProfileSchedule, s conversion.Scope) error {
+	out.Cron = in.Cron
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.Jitter = (*v1.Duration)(unsafe.Pointer(in.Jitter))
+	return nil
+}
+
+// Convert_api_ProfileSchedule_To_v1alpha2_ProfileSchedule is an autogenerated conversion function.
+func Convert_api_ProfileSchedule_To_v1alpha2_ProfileSchedule(in *api.ProfileSchedule, out *ProfileSchedule, s conversion.Scope) error {
+	return autoConvert_api_ProfileSchedule_To_v1alpha2_ProfileSchedule(in, out, s)
+}
+
 func autoConvert_v1alpha2_Prometheus_To_api_Prometheus(in *Prometheus, out *api.Prometheus, s conversion.Scope) error {
 	out.URL = in.URL
 	out.AuthToken = (*api.AuthToken)(unsafe.Pointer(in.AuthToken))
@@ -414,6 +620,26 @@ func Convert_api_Prometheus_To_v1alpha2_Prometheus(in *api.Prometheus, out *Prom
 	return autoConvert_api_Prometheus_To_v1alpha2_Prometheus(in, out, s)
 }
 
+func autoConvert_v1alpha2_RollingEvictions_To_api_RollingEvictions(in *RollingEvictions, out *api.RollingEvictions, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha2_RollingEvictions_To_api_RollingEvictions is an autogenerated conversion function.
+func Convert_v1alpha2_RollingEvictions_To_api_RollingEvictions(in *RollingEvictions, out *api.RollingEvictions, s conversion.Scope) error {
+	return autoConvert_v1alpha2_RollingEvictions_To_api_RollingEvictions(in, out, s)
+}
+
+func autoConvert_api_RollingEvictions_To_v1alpha2_RollingEvictions(in *api.RollingEvictions, out *RollingEvictions, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_api_RollingEvictions_To_v1alpha2_RollingEvictions is an autogenerated conversion function.
+func Convert_api_RollingEvictions_To_v1alpha2_RollingEvictions(in *api.RollingEvictions, out *RollingEvictions, s conversion.Scope) error {
+	return autoConvert_api_RollingEvictions_To_v1alpha2_RollingEvictions(in, out, s)
+}
+
 func autoConvert_v1alpha2_SecretReference_To_api_SecretReference(in *SecretReference, out *api.SecretReference, s conversion.Scope) error {
 	out.Namespace = in.Namespace
 	out.Name = in.Name
@@ -22,7 +22,9 @@ limitations under the License.
 package api
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -46,6 +48,22 @@ func (in *AuthToken) DeepCopy() *AuthToken {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeschedulerPolicy) DeepCopyInto(out *DeschedulerPolicy) {
 	*out = *in
@@ -99,6 +117,31 @@ func (in *DeschedulerPolicy) DeepCopyInto(out *DeschedulerPolicy) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(uint)
+		**out = **in
+	}
+	if in.EvictionRateLimits != nil {
+		in, out := &in.EvictionRateLimits, &out.EvictionRateLimits
+		*out = new(EvictionRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingEvictions != nil {
+		in, out := &in.RollingEvictions, &out.RollingEvictions
+		*out = new(RollingEvictions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailablePerOwner != nil {
+		in, out := &in.MaxUnavailablePerOwner, &out.MaxUnavailablePerOwner
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.EvictionPlanning != nil {
+		in, out := &in.EvictionPlanning, &out.EvictionPlanning
+		*out = new(EvictionPlanning)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -131,6 +174,36 @@ func (in *DeschedulerProfile) DeepCopyInto(out *DeschedulerProfile) {
 		}
 	}
 	in.Plugins.DeepCopyInto(&out.Plugins)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxNoOfPodsToEvictPerNode != nil {
+		in, out := &in.MaxNoOfPodsToEvictPerNode, &out.MaxNoOfPodsToEvictPerNode
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxNoOfPodsToEvictPerNamespace != nil {
+		in, out := &in.MaxNoOfPodsToEvictPerNamespace, &out.MaxNoOfPodsToEvictPerNamespace
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxNoOfPodsToEvictTotal != nil {
+		in, out := &in.MaxNoOfPodsToEvictTotal, &out.MaxNoOfPodsToEvictTotal
+		*out = new(uint)
+		**out = **in
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ProfileSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -165,6 +238,79 @@ func (in *EvictionLimits) DeepCopy() *EvictionLimits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionPlanning) DeepCopyInto(out *EvictionPlanning) {
+	*out = *in
+	if in.ProfileWeights != nil {
+		in, out := &in.ProfileWeights, &out.ProfileWeights
+		*out = make(map[string]uint, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespaceWeights != nil {
+		in, out := &in.NamespaceWeights, &out.NamespaceWeights
+		*out = make(map[string]uint, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionPlanning.
+func (in *EvictionPlanning) DeepCopy() *EvictionPlanning {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionPlanning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionRateLimit) DeepCopyInto(out *EvictionRateLimit) {
+	*out = *in
+	out.Window = in.Window
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionRateLimit.
+func (in *EvictionRateLimit) DeepCopy() *EvictionRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionRateLimits) DeepCopyInto(out *EvictionRateLimits) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]EvictionRateLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.StateConfigMap != nil {
+		in, out := &in.StateConfigMap, &out.StateConfigMap
+		*out = new(ConfigMapReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionRateLimits.
+func (in *EvictionRateLimits) DeepCopy() *EvictionRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsCollector) DeepCopyInto(out *MetricsCollector) {
 	*out = *in
@@ -282,6 +428,7 @@ func (in *Plugins) DeepCopyInto(out *Plugins) {
 	in.Balance.DeepCopyInto(&out.Balance)
 	in.Filter.DeepCopyInto(&out.Filter)
 	in.PreEvictionFilter.DeepCopyInto(&out.PreEvictionFilter)
+	in.PostEviction.DeepCopyInto(&out.PostEviction)
 	return
 }
 
@@ -316,6 +463,37 @@ func (in *PriorityThreshold) DeepCopy() *PriorityThreshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSchedule) DeepCopyInto(out *ProfileSchedule) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSchedule.
+func (in *ProfileSchedule) DeepCopy() *ProfileSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 	*out = *in
@@ -359,6 +537,27 @@ func (in ResourceThresholds) DeepCopy() ResourceThresholds {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingEvictions) DeepCopyInto(out *RollingEvictions) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingEvictions.
+func (in *RollingEvictions) DeepCopy() *RollingEvictions {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingEvictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
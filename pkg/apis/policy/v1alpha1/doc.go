@@ -0,0 +1,20 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package,register
+
+// Package v1alpha1 is the v1alpha1 version of the DeschedulerPolicy custom resource,
+// a policy managed as a cluster scoped object instead of a file
+// +groupName=descheduler.sigs.k8s.io
+
+package v1alpha1 // import "sigs.k8s.io/descheduler/pkg/apis/policy/v1alpha1"
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+)
+
+const (
+	// ValidCondition tells whether the descheduler accepted the policy
+	ValidCondition = "Valid"
+
+	// AcceptedReason is the reason of a policy the descheduler runs
+	AcceptedReason = "Accepted"
+	// RejectedReason is the reason of a policy failing to load or requiring a restart,
+	// the message of the condition tells why
+	RejectedReason = "Rejected"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeschedulerPolicy is a descheduler policy managed as a cluster scoped object
+type DeschedulerPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the policy in the format of the descheduler/v1alpha2 policy file.
+	// The apiVersion and kind of the policy file are not set.
+	Spec v1alpha2.DeschedulerPolicy `json:"spec"`
+
+	// Status is reported by the descheduler running the policy
+	Status DeschedulerPolicyStatus `json:"status,omitempty"`
+}
+
+// DeschedulerPolicyStatus reports whether the policy is accepted and the outcome of the last descheduling cycle
+type DeschedulerPolicyStatus struct {
+	// ObservedGeneration is the generation of the policy last checked by the descheduler
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions of the policy, the Valid condition tells whether the descheduler accepted the policy
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastCycleTime is when the last descheduling cycle finished
+	LastCycleTime *metav1.Time `json:"lastCycleTime,omitempty"`
+
+	// Evicted is the number of pods evicted in the last descheduling cycle
+	Evicted int32 `json:"evicted,omitempty"`
+
+	// Profiles report the evictions of the profiles run in the last descheduling cycle
+	Profiles []ProfileStatus `json:"profiles,omitempty"`
+}
+
+// ProfileStatus reports the evictions of a profile in the last descheduling cycle
+type ProfileStatus struct {
+	// Name of the profile
+	Name string `json:"name"`
+
+	// Evicted is the number of pods evicted by all the plugins of the profile
+	Evicted int32 `json:"evicted"`
+
+	// Plugins report the evictions of the plugins of the profile
+	Plugins []PluginStatus `json:"plugins,omitempty"`
+}
+
+// PluginStatus reports the evictions of a plugin in the last descheduling cycle
+type PluginStatus struct {
+	// Name of the plugin
+	Name string `json:"name"`
+
+	// ExtensionPoint the plugin ran at, i.e. Deschedule or Balance
+	ExtensionPoint string `json:"extensionPoint"`
+
+	// Evicted is the number of pods evicted by the plugin
+	Evicted int32 `json:"evicted"`
+
+	// Skipped is the number of pods the plugin attempted to evict and did not
+	Skipped int32 `json:"skipped,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeschedulerPolicyList is a list of DeschedulerPolicy objects
+type DeschedulerPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DeschedulerPolicy `json:"items"`
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/prometheus/common/config"
 
 	// Ensure to load all auth plugins.
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
@@ -81,6 +82,15 @@ func CreateClient(clientConnection componentbaseconfig.ClientConnectionConfigura
 	return clientset.NewForConfig(cfg)
 }
 
+func CreateDynamicClient(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt string) (dynamic.Interface, error) {
+	cfg, err := createConfig(clientConnection, userAgt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create config: %v", err)
+	}
+
+	return dynamic.NewForConfig(cfg)
+}
+
 func CreateMetricsClient(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt string) (metricsclient.Interface, error) {
 	cfg, err := createConfig(clientConnection, userAgt)
 	if err != nil {
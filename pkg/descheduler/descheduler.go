@@ -31,22 +31,25 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1"
-	policyv1 "k8s.io/api/policy/v1"
-	schedulingv1 "k8s.io/api/scheduling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	utilversion "k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/events"
@@ -63,6 +66,9 @@ import (
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/descheduler/schedule"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	frameworkprofile "sigs.k8s.io/descheduler/pkg/framework/profile"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
@@ -79,8 +85,31 @@ const (
 type eprunner func(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status
 
 type profileRunner struct {
-	name                      string
+	name string
+	// nodeSelector narrows down the nodes the profile operates over
+	nodeSelector              labels.Selector
 	descheduleEPs, balanceEPs eprunner
+	updateHandle              func(opts ...frameworkprofile.Option) error
+	beforeCycle               func(ctx context.Context) error
+	afterCycle                func(ctx context.Context)
+	report                    func() *frameworktypes.CycleReport
+	close                     func() error
+	// evictor the plugins of the profile evict through
+	evictor frameworktypes.Evictor
+}
+
+// nodes returns the nodes matching the node selector of the profile
+func (pr *profileRunner) nodes(nodes []*v1.Node) []*v1.Node {
+	if pr.nodeSelector == nil || pr.nodeSelector.Empty() {
+		return nodes
+	}
+	var selected []*v1.Node
+	for _, node := range nodes {
+		if pr.nodeSelector.Matches(labels.Set(node.Labels)) {
+			selected = append(selected, node)
+		}
+	}
+	return selected
 }
 
 type descheduler struct {
@@ -88,8 +117,10 @@ type descheduler struct {
 	ir                                *informerResources
 	getPodsAssignedToNode             podutil.GetPodsAssignedToNodeFunc
 	sharedInformerFactory             informers.SharedInformerFactory
+	dynamicInformerFactory            dynamicinformer.DynamicSharedInformerFactory
 	namespacedSecretsLister           corev1listers.SecretNamespaceLister
 	deschedulerPolicy                 *api.DeschedulerPolicy
+	evictionPolicyGroupVersion        string
 	eventRecorder                     events.EventRecorder
 	podEvictor                        *evictions.PodEvictor
 	podEvictionReactionFnc            func(*fakeclientset.Clientset) func(action core.Action) (bool, runtime.Object, error)
@@ -99,28 +130,63 @@ type descheduler struct {
 	queue                             workqueue.RateLimitingInterface
 	currentPrometheusAuthToken        string
 	metricsProviders                  map[api.MetricsSource]*api.MetricsProvider
+	// parallelizer runs profiles and work pieces of plugins in a bounded pool of workers
+	parallelizer parallelize.Parallelizer
+	// profileRunners are built once and reused across descheduling cycles,
+	// indexed the same way as the profiles of the policy. A nil item means
+	// the profile failed to build and its build is retried in the next cycle.
+	profileRunners []*profileRunner
+	// scheduler tracks when the profiles are due, nil unless any of the profiles has its own schedule
+	scheduler *schedule.Scheduler
+	// dueProfiles are the names of the profiles run in the current cycle, all the profiles run when nil
+	dueProfiles sets.Set[string]
+	// reports of the profiles run in the last descheduling cycle
+	reports []*frameworktypes.CycleReport
+	// policyContent is the content of the policy file last checked for changes, nil until the first check
+	policyContent []byte
+	// policyResource is the DeschedulerPolicy object the policy is read from, nil when read from the policy file
+	policyResource *policyResource
 }
 
 type informerResources struct {
-	sharedInformerFactory informers.SharedInformerFactory
-	resourceToInformer    map[schema.GroupVersionResource]informers.GenericInformer
+	sharedInformerFactory  informers.SharedInformerFactory
+	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	restMapper             meta.RESTMapper
+	resourceToInformer     map[schema.GroupVersionResource]informers.GenericInformer
+	// resources unknown to the kubernetes client set (e.g. custom resources)
+	dynamicResourceToInformer map[schema.GroupVersionResource]informers.GenericInformer
+	dynamicResourceToListKind map[schema.GroupVersionResource]string
 }
 
-func newInformerResources(sharedInformerFactory informers.SharedInformerFactory) *informerResources {
+func newInformerResources(sharedInformerFactory informers.SharedInformerFactory, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, restMapper meta.RESTMapper) *informerResources {
 	return &informerResources{
-		sharedInformerFactory: sharedInformerFactory,
-		resourceToInformer:    make(map[schema.GroupVersionResource]informers.GenericInformer),
+		sharedInformerFactory:     sharedInformerFactory,
+		dynamicInformerFactory:    dynamicInformerFactory,
+		restMapper:                restMapper,
+		resourceToInformer:        make(map[schema.GroupVersionResource]informers.GenericInformer),
+		dynamicResourceToInformer: make(map[schema.GroupVersionResource]informers.GenericInformer),
+		dynamicResourceToListKind: make(map[schema.GroupVersionResource]string),
 	}
 }
 
 func (ir *informerResources) Uses(resources ...schema.GroupVersionResource) error {
 	for _, resource := range resources {
 		informer, err := ir.sharedInformerFactory.ForResource(resource)
-		if err != nil {
+		if err == nil {
+			ir.resourceToInformer[resource] = informer
+			continue
+		}
+		if ir.dynamicInformerFactory == nil || ir.restMapper == nil {
 			return err
 		}
 
-		ir.resourceToInformer[resource] = informer
+		// Not known to the kubernetes client set, fall back to the dynamic informers
+		kind, err := ir.restMapper.KindFor(resource)
+		if err != nil {
+			return fmt.Errorf("unable to find kind of %s resource: %w", resource, err)
+		}
+		ir.dynamicResourceToInformer[resource] = ir.dynamicInformerFactory.ForResource(resource)
+		ir.dynamicResourceToListKind[resource] = kind.Kind + "List"
 	}
 	return nil
 }
@@ -145,6 +211,80 @@ func (ir *informerResources) CopyTo(fakeClient *fakeclientset.Clientset, newFact
 	return nil
 }
 
+// CopyDynamicTo builds a fake dynamic client holding the objects of all the dynamic informers
+// and subscribes a new dynamic informer factory built over the client to the same resources.
+// Returns nil when no dynamic resources are used.
+func (ir *informerResources) CopyDynamicTo() (*dynamicfake.FakeDynamicClient, dynamicinformer.DynamicSharedInformerFactory, error) {
+	if len(ir.dynamicResourceToInformer) == 0 {
+		return nil, nil, nil
+	}
+
+	fakeDynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), ir.dynamicResourceToListKind)
+	newFactory := dynamicinformer.NewDynamicSharedInformerFactory(fakeDynamicClient, 0)
+	for resource, informer := range ir.dynamicResourceToInformer {
+		newFactory.ForResource(resource)
+
+		objects, err := informer.Lister().List(labels.Everything())
+		if err != nil {
+			return nil, nil, fmt.Errorf("error listing %s: %w", resource, err)
+		}
+
+		for _, object := range objects {
+			accessor, err := meta.Accessor(object)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error accessing %s object: %w", resource, err)
+			}
+			if err := fakeDynamicClient.Tracker().Create(resource, object, accessor.GetNamespace()); err != nil {
+				return nil, nil, fmt.Errorf("error copying %s object: %w", resource, err)
+			}
+		}
+	}
+	return fakeDynamicClient, newFactory, nil
+}
+
+// requiredResources collects the resources declared by the plugins enabled in any of the profiles
+func requiredResources(deschedulerPolicy *api.DeschedulerPolicy, registry pluginregistry.Registry) []schema.GroupVersionResource {
+	var resources []schema.GroupVersionResource
+	seen := sets.New[schema.GroupVersionResource]()
+	for _, profile := range deschedulerPolicy.Profiles {
+		pluginNames := append([]string{}, profile.Plugins.PreSort.Enabled...)
+		pluginNames = append(pluginNames, profile.Plugins.Sort.Enabled...)
+		pluginNames = append(pluginNames, profile.Plugins.Deschedule.Enabled...)
+		pluginNames = append(pluginNames, profile.Plugins.Balance.Enabled...)
+		pluginNames = append(pluginNames, profile.Plugins.Filter.Enabled...)
+		pluginNames = append(pluginNames, profile.Plugins.PreEvictionFilter.Enabled...)
+		pluginNames = append(pluginNames, profile.Plugins.PostEviction.Enabled...)
+		for _, pluginName := range pluginNames {
+			pluginUtilities, ok := registry[pluginName]
+			if !ok {
+				continue
+			}
+			resourcesPlugin, ok := pluginUtilities.PluginType.(frameworktypes.ResourcesPlugin)
+			if !ok {
+				continue
+			}
+			for _, resource := range resourcesPlugin.RequiredResources() {
+				if !seen.Has(resource) {
+					seen.Insert(resource)
+					resources = append(resources, resource)
+				}
+			}
+		}
+	}
+	return resources
+}
+
+// informedResources returns the resources the descheduler keeps informers of. Pods, nodes and
+// pod disruption budgets are used by the descheduler itself, the rest is declared by the plugins.
+func informedResources(deschedulerPolicy *api.DeschedulerPolicy) []schema.GroupVersionResource {
+	resources := []schema.GroupVersionResource{
+		v1.SchemeGroupVersion.WithResource("pods"),
+		v1.SchemeGroupVersion.WithResource("nodes"),
+		policy.SchemeGroupVersion.WithResource("poddisruptionbudgets"),
+	}
+	return append(resources, requiredResources(deschedulerPolicy, pluginregistry.PluginRegistry)...)
+}
+
 func metricsProviderListToMap(providersList []api.MetricsProvider) map[api.MetricsSource]*api.MetricsProvider {
 	providersMap := make(map[api.MetricsSource]*api.MetricsProvider)
 	for _, provider := range providersList {
@@ -156,16 +296,17 @@ func metricsProviderListToMap(providersList []api.MetricsProvider) map[api.Metri
 func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string, eventRecorder events.EventRecorder, sharedInformerFactory, namespacedSharedInformerFactory informers.SharedInformerFactory) (*descheduler, error) {
 	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
 
-	ir := newInformerResources(sharedInformerFactory)
-	ir.Uses(v1.SchemeGroupVersion.WithResource("pods"),
-		v1.SchemeGroupVersion.WithResource("nodes"),
-		// Future work could be to let each plugin declare what type of resources it needs; that way dry runs would stay
-		// consistent with the real runs without having to keep the list here in sync.
-		v1.SchemeGroupVersion.WithResource("namespaces"),                 // Used by the defaultevictor plugin
-		schedulingv1.SchemeGroupVersion.WithResource("priorityclasses"),  // Used by the defaultevictor plugin
-		policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"), // Used by the defaultevictor plugin
+	var dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	var restMapper meta.RESTMapper
+	if rs.DynamicClient != nil {
+		dynamicInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(rs.DynamicClient, 0)
+		restMapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(rs.Client.Discovery()))
+	}
 
-	) // Used by the defaultevictor plugin
+	ir := newInformerResources(sharedInformerFactory, dynamicInformerFactory, restMapper)
+	if err := ir.Uses(informedResources(deschedulerPolicy)...); err != nil {
+		return nil, fmt.Errorf("unable to set up informers: %v", err)
+	}
 
 	getPodsAssignedToNode, err := podutil.BuildGetPodsAssignedToNodeFunc(podInformer)
 	if err != nil {
@@ -178,42 +319,42 @@ func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedu
 		eventRecorder,
 		podInformer,
 		rs.DefaultFeatureGates,
-		evictions.NewOptions().
-			WithPolicyGroupVersion(evictionPolicyGroupVersion).
-			WithMaxPodsToEvictPerNode(deschedulerPolicy.MaxNoOfPodsToEvictPerNode).
-			WithMaxPodsToEvictPerNamespace(deschedulerPolicy.MaxNoOfPodsToEvictPerNamespace).
-			WithMaxPodsToEvictTotal(deschedulerPolicy.MaxNoOfPodsToEvictTotal).
-			WithEvictionFailureEventNotification(deschedulerPolicy.EvictionFailureEventNotification).
-			WithGracePeriodSeconds(deschedulerPolicy.GracePeriodSeconds).
-			WithDryRun(rs.DryRun).
-			WithMetricsEnabled(!rs.DisableMetrics),
+		newEvictionOptions(rs, deschedulerPolicy, evictionPolicyGroupVersion),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	desch := &descheduler{
-		rs:                     rs,
-		ir:                     ir,
-		getPodsAssignedToNode:  getPodsAssignedToNode,
-		sharedInformerFactory:  sharedInformerFactory,
-		deschedulerPolicy:      deschedulerPolicy,
-		eventRecorder:          eventRecorder,
-		podEvictor:             podEvictor,
-		podEvictionReactionFnc: podEvictionReactionFnc,
-		prometheusClient:       rs.PrometheusClient,
-		queue:                  workqueue.NewRateLimitingQueueWithConfig(workqueue.DefaultControllerRateLimiter(), workqueue.RateLimitingQueueConfig{Name: "descheduler"}),
-		metricsProviders:       metricsProviderListToMap(deschedulerPolicy.MetricsProviders),
+		rs:                         rs,
+		ir:                         ir,
+		getPodsAssignedToNode:      getPodsAssignedToNode,
+		sharedInformerFactory:      sharedInformerFactory,
+		dynamicInformerFactory:     dynamicInformerFactory,
+		deschedulerPolicy:          deschedulerPolicy,
+		evictionPolicyGroupVersion: evictionPolicyGroupVersion,
+		eventRecorder:              eventRecorder,
+		podEvictor:                 podEvictor,
+		podEvictionReactionFnc:     podEvictionReactionFnc,
+		prometheusClient:           rs.PrometheusClient,
+		queue:                      workqueue.NewRateLimitingQueueWithConfig(workqueue.DefaultControllerRateLimiter(), workqueue.RateLimitingQueueConfig{Name: "descheduler"}),
+		metricsProviders:           metricsProviderListToMap(deschedulerPolicy.MetricsProviders),
+		parallelizer:               parallelize.NewParallelizer(parallelize.DefaultParallelism),
+	}
+	if deschedulerPolicy.Concurrency != nil {
+		desch.parallelizer = parallelize.NewParallelizer(int(*deschedulerPolicy.Concurrency))
 	}
 
+	scheduler, err := newProfileScheduler(deschedulerPolicy.Profiles, rs.DeschedulingInterval, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	desch.scheduler = scheduler
+
 	if rs.MetricsClient != nil {
-		nodeSelector := labels.Everything()
-		if deschedulerPolicy.NodeSelector != nil {
-			sel, err := labels.Parse(*deschedulerPolicy.NodeSelector)
-			if err != nil {
-				return nil, err
-			}
-			nodeSelector = sel
+		nodeSelector, err := policyNodeSelector(deschedulerPolicy)
+		if err != nil {
+			return nil, err
 		}
 		desch.metricsCollector = metricscollector.NewMetricsCollector(sharedInformerFactory.Core().V1().Nodes().Lister(), rs.MetricsClient, nodeSelector)
 	}
@@ -234,6 +375,43 @@ func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedu
 	return desch, nil
 }
 
+// newEvictionOptions returns the options of the pod evictor given by the policy
+func newEvictionOptions(rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string) *evictions.Options {
+	evictionOptions := evictions.NewOptions().
+		WithPolicyGroupVersion(evictionPolicyGroupVersion).
+		WithMaxPodsToEvictPerNode(deschedulerPolicy.MaxNoOfPodsToEvictPerNode).
+		WithMaxPodsToEvictPerNamespace(deschedulerPolicy.MaxNoOfPodsToEvictPerNamespace).
+		WithMaxPodsToEvictTotal(deschedulerPolicy.MaxNoOfPodsToEvictTotal).
+		WithEvictionFailureEventNotification(deschedulerPolicy.EvictionFailureEventNotification).
+		WithGracePeriodSeconds(deschedulerPolicy.GracePeriodSeconds).
+		WithMaxUnavailablePerOwner(deschedulerPolicy.MaxUnavailablePerOwner).
+		WithDryRun(rs.DryRun).
+		WithMetricsEnabled(!rs.DisableMetrics)
+	for _, profile := range deschedulerPolicy.Profiles {
+		evictionOptions.WithProfileOptions(profile.Name, evictions.NewProfileOptions().
+			WithMaxPodsToEvictPerNode(profile.MaxNoOfPodsToEvictPerNode).
+			WithMaxPodsToEvictPerNamespace(profile.MaxNoOfPodsToEvictPerNamespace).
+			WithMaxPodsToEvictTotal(profile.MaxNoOfPodsToEvictTotal).
+			WithGracePeriodSeconds(profile.GracePeriodSeconds),
+		)
+	}
+	if rateLimits := deschedulerPolicy.EvictionRateLimits; rateLimits != nil {
+		evictionOptions.WithRateLimits(evictionRateLimits(rateLimits.Limits))
+		// evictions simulated in the dry run mode do not consume the persisted budget
+		if rateLimits.StateConfigMap != nil && !rs.DryRun {
+			evictionOptions.WithRateLimitStore(evictions.NewConfigMapRateLimitStore(rs.Client, rateLimits.StateConfigMap.Namespace, rateLimits.StateConfigMap.Name))
+		}
+	}
+	if rolling := deschedulerPolicy.RollingEvictions; rolling != nil {
+		timeout := evictions.DefaultRollingEvictionTimeout
+		if rolling.Timeout != nil {
+			timeout = rolling.Timeout.Duration
+		}
+		evictionOptions.WithRollingEvictions(timeout)
+	}
+	return evictionOptions
+}
+
 func (d *descheduler) reconcileInClusterSAToken() error {
 	// Read the sa token and assume it has the sufficient permissions to authenticate
 	cfg, err := rest.InClusterConfig()
@@ -375,6 +553,10 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 		if err != nil {
 			return err
 		}
+		// the budgets are computed from the copied pods before the informers start
+		if err := evictions.NewDryRunEvictionAPI(fakeClient.Tracker()).SyncBudgets(); err != nil {
+			return err
+		}
 
 		// create a new instance of the shared informer factor from the cached client
 		// register the pod informer, otherwise it will not get running
@@ -383,10 +565,20 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 			return fmt.Errorf("build get pods assigned to node function error: %v", err)
 		}
 
+		_, fakeDynamicInformerFactory, err := d.ir.CopyDynamicTo()
+		if err != nil {
+			return err
+		}
+
 		fakeCtx, cncl := context.WithCancel(context.TODO())
 		defer cncl()
 		fakeSharedInformerFactory.Start(fakeCtx.Done())
 		fakeSharedInformerFactory.WaitForCacheSync(fakeCtx.Done())
+		if fakeDynamicInformerFactory != nil {
+			fakeDynamicInformerFactory.Start(fakeCtx.Done())
+			fakeDynamicInformerFactory.WaitForCacheSync(fakeCtx.Done())
+			d.dynamicInformerFactory = fakeDynamicInformerFactory
+		}
 
 		client = fakeClient
 		d.sharedInformerFactory = fakeSharedInformerFactory
@@ -396,15 +588,88 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 
 	klog.V(3).Infof("Setting up the pod evictor")
 	d.podEvictor.SetClient(client)
+	d.podEvictor.SetPodDisruptionBudgetLister(d.sharedInformerFactory.Policy().V1().PodDisruptionBudgets().Lister())
+	d.podEvictor.SetPodIndexer(d.sharedInformerFactory.Core().V1().Pods().Informer().GetIndexer())
 	d.podEvictor.ResetCounters()
+	// the rate limits span cycles, the state is reloaded in case another instance evicted in the meantime
+	if err := d.podEvictor.LoadRateLimitState(ctx); err != nil {
+		klog.ErrorS(err, "unable to load the eviction rate limit state, using the state in memory")
+	}
 
 	d.runProfiles(ctx, client, nodes)
 
+	if err := d.podEvictor.SaveRateLimitState(ctx); err != nil {
+		klog.ErrorS(err, "unable to save the eviction rate limit state")
+	}
+
 	klog.V(1).InfoS("Number of evictions/requests", "totalEvicted", d.podEvictor.TotalEvicted(), "evictionRequests", d.podEvictor.TotalEvictionRequests())
 
 	return nil
 }
 
+// buildProfileRunner builds a profile and initializes its plugins
+func (d *descheduler) buildProfileRunner(ctx context.Context, profile api.DeschedulerProfile, opts ...frameworkprofile.Option) (*profileRunner, error) {
+	nodeSelector := labels.Everything()
+	if profile.NodeSelector != nil {
+		sel, err := labels.Parse(*profile.NodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the node selector of profile %q: %v", profile.Name, err)
+		}
+		nodeSelector = sel
+	}
+	currProfile, err := frameworkprofile.NewProfile(profile, pluginregistry.PluginRegistry, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := currProfile.Init(ctx); err != nil {
+		return nil, err
+	}
+	return &profileRunner{
+		name:          profile.Name,
+		nodeSelector:  nodeSelector,
+		descheduleEPs: currProfile.RunDeschedulePlugins,
+		balanceEPs:    currProfile.RunBalancePlugins,
+		updateHandle:  currProfile.UpdateHandle,
+		beforeCycle:   currProfile.BeforeCycle,
+		afterCycle:    currProfile.AfterCycle,
+		report:        currProfile.Report,
+		close:         currProfile.Close,
+		evictor:       currProfile.Evictor(),
+	}, nil
+}
+
+// closeProfiles closes all the profiles built so far. The profiles
+// are built again from the current policy in the next cycle.
+func (d *descheduler) closeProfiles() {
+	closeProfileRunners(d.profileRunners)
+	d.profileRunners = nil
+}
+
+func closeProfileRunners(profileRunners []*profileRunner) {
+	for _, profileR := range profileRunners {
+		if profileR == nil {
+			continue
+		}
+		if err := profileR.close(); err != nil {
+			klog.ErrorS(err, "unable to close a profile", "profile", profileR.name)
+		}
+	}
+}
+
+// handleOptions returns the options of the handles the plugins access the cluster through
+func (d *descheduler) handleOptions(client clientset.Interface) []frameworkprofile.Option {
+	return []frameworkprofile.Option{
+		frameworkprofile.WithClientSet(client),
+		frameworkprofile.WithSharedInformerFactory(d.sharedInformerFactory),
+		frameworkprofile.WithDynamicInformerFactory(d.dynamicInformerFactory),
+		frameworkprofile.WithPodEvictor(d.podEvictor),
+		frameworkprofile.WithGetPodsAssignedToNodeFnc(d.getPodsAssignedToNode),
+		frameworkprofile.WithMetricsCollector(d.metricsCollector),
+		frameworkprofile.WithPrometheusClient(d.prometheusClient),
+		frameworkprofile.WithParallelism(d.parallelizer.Parallelism()),
+	}
+}
+
 // runProfiles runs all the deschedule plugins of all profiles and
 // later runs through all balance plugins of all profiles. (All Balance plugins should come after all Deschedule plugins)
 // see https://github.com/kubernetes-sigs/descheduler/issues/979
@@ -412,43 +677,169 @@ func (d *descheduler) runProfiles(ctx context.Context, client clientset.Interfac
 	var span trace.Span
 	ctx, span = tracing.Tracer().Start(ctx, "runProfiles")
 	defer span.End()
-	var profileRunners []profileRunner
-	for _, profile := range d.deschedulerPolicy.Profiles {
-		currProfile, err := frameworkprofile.NewProfile(
-			profile,
-			pluginregistry.PluginRegistry,
-			frameworkprofile.WithClientSet(client),
-			frameworkprofile.WithSharedInformerFactory(d.sharedInformerFactory),
-			frameworkprofile.WithPodEvictor(d.podEvictor),
-			frameworkprofile.WithGetPodsAssignedToNodeFnc(d.getPodsAssignedToNode),
-			frameworkprofile.WithMetricsCollector(d.metricsCollector),
-			frameworkprofile.WithPrometheusClient(d.prometheusClient),
-		)
-		if err != nil {
-			klog.ErrorS(err, "unable to create a profile", "profile", profile.Name)
+
+	handleOpts := d.handleOptions(client)
+
+	// In the plan-then-execute mode plugins propose their evictions, which are
+	// ranked and executed once all the profiles finished
+	var planner *plan.Planner
+	if planning := d.deschedulerPolicy.EvictionPlanning; planning != nil {
+		planner = plan.NewPlanner(planning.ProfileWeights, planning.NamespaceWeights)
+		handleOpts = append(handleOpts, frameworkprofile.WithEvictionPlanner(planner))
+	}
+
+	if d.profileRunners == nil {
+		d.profileRunners = make([]*profileRunner, len(d.deschedulerPolicy.Profiles))
+	}
+
+	var profileRunners []*profileRunner
+	// nodes selected by each of the profile runners
+	var profileNodes [][]*v1.Node
+	for idx, profile := range d.deschedulerPolicy.Profiles {
+		if d.dueProfiles != nil && !d.dueProfiles.Has(profile.Name) {
 			continue
 		}
-		profileRunners = append(profileRunners, profileRunner{profile.Name, currProfile.RunDeschedulePlugins, currProfile.RunBalancePlugins})
+		if d.profileRunners[idx] == nil {
+			profileR, err := d.buildProfileRunner(ctx, profile, handleOpts...)
+			if err != nil {
+				klog.ErrorS(err, "unable to create a profile", "profile", profile.Name)
+				continue
+			}
+			d.profileRunners[idx] = profileR
+		} else if err := d.profileRunners[idx].updateHandle(handleOpts...); err != nil {
+			klog.ErrorS(err, "unable to update a profile", "profile", profile.Name)
+			continue
+		}
+
+		profileR := d.profileRunners[idx]
+		selected := profileR.nodes(nodes)
+		if len(selected) <= 1 {
+			klog.V(1).InfoS("Skipping a profile for the current cycle, the profile selects 0 or 1 nodes meaning eviction causes service disruption or degradation", "profile", profileR.name, "nodes", len(selected))
+			continue
+		}
+		if err := profileR.beforeCycle(ctx); err != nil {
+			klog.ErrorS(err, "skipping a profile for the current cycle", "profile", profileR.name)
+			continue
+		}
+		profileRunners = append(profileRunners, profileR)
+		profileNodes = append(profileNodes, selected)
 	}
 
-	for _, profileR := range profileRunners {
+	// Profiles are independent of each other and share the eviction limits only.
+	// All Deschedule plugins still finish before any Balance plugin starts.
+	d.parallelizer.Until(ctx, len(profileRunners), func(piece int) {
 		// First deschedule
-		status := profileR.descheduleEPs(ctx, nodes)
+		profileR := profileRunners[piece]
+		status := profileR.descheduleEPs(ctx, profileNodes[piece])
 		if status != nil && status.Err != nil {
 			span.AddEvent("failed to perform deschedule operations", trace.WithAttributes(attribute.String("err", status.Err.Error()), attribute.String("profile", profileR.name), attribute.String("operation", tracing.DescheduleOperation)))
 			klog.ErrorS(status.Err, "running deschedule extension point failed with error", "profile", profileR.name)
-			continue
 		}
-	}
+	})
 
-	for _, profileR := range profileRunners {
+	d.parallelizer.Until(ctx, len(profileRunners), func(piece int) {
 		// Balance Later
-		status := profileR.balanceEPs(ctx, nodes)
+		profileR := profileRunners[piece]
+		status := profileR.balanceEPs(ctx, profileNodes[piece])
 		if status != nil && status.Err != nil {
 			span.AddEvent("failed to perform balance operations", trace.WithAttributes(attribute.String("err", status.Err.Error()), attribute.String("profile", profileR.name), attribute.String("operation", tracing.BalanceOperation)))
 			klog.ErrorS(status.Err, "running balance extension point failed with error", "profile", profileR.name)
-			continue
 		}
+	})
+
+	if planner != nil {
+		klog.V(1).InfoS("Executing the eviction plan", "candidates", planner.Len())
+		for _, result := range planner.Execute(ctx) {
+			if result.Err != nil {
+				klog.V(2).InfoS("Planned eviction not executed", "pod", klog.KObj(result.Candidate.Pod), "profile", result.Candidate.Options.ProfileName, "proposers", result.Candidate.Proposers, "err", result.Err)
+			}
+		}
+	}
+
+	d.reports = nil
+	for _, profileR := range profileRunners {
+		profileR.afterCycle(ctx)
+		report := profileR.report()
+		d.reports = append(d.reports, report)
+		klog.V(1).InfoS("Profile finished", "profile", report.Profile, "evictedPods", report.Evicted(), "skippedPods", report.Skipped(), "codes", report.Codes())
+		eventType := v1.EventTypeNormal
+		if report.Codes()[frameworktypes.Error] > 0 {
+			eventType = v1.EventTypeWarning
+		}
+		d.recordEvent(eventType, "ProfileFinished", "Descheduling", "profile %v evicted %v pods and skipped %v pods, plugins finished with %v", report.Profile, report.Evicted(), report.Skipped(), report.Codes())
+	}
+}
+
+func evictionRateLimits(limits []api.EvictionRateLimit) []evictions.RateLimit {
+	var rateLimits []evictions.RateLimit
+	for _, limit := range limits {
+		rateLimits = append(rateLimits, evictions.RateLimit{
+			PerNamespace: limit.Scope == api.NamespaceRateLimitScope,
+			MaxEvictions: limit.MaxEvictions,
+			Window:       limit.Window.Duration,
+		})
+	}
+	return rateLimits
+}
+
+// hasProfileSchedules reports whether any of the profiles has its own schedule
+func hasProfileSchedules(profiles []api.DeschedulerProfile) bool {
+	for _, profile := range profiles {
+		if profile.Schedule != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// newProfileScheduler schedules the profiles when any of them has its own schedule.
+// The remaining profiles run every descheduling interval, or once when there is no interval.
+func newProfileScheduler(profiles []api.DeschedulerProfile, deschedulingInterval time.Duration, now time.Time) (*schedule.Scheduler, error) {
+	if !hasProfileSchedules(profiles) {
+		return nil, nil
+	}
+
+	scheduler := schedule.NewScheduler()
+	for _, profile := range profiles {
+		profileSchedule := schedule.Once()
+		var jitter time.Duration
+		switch {
+		case profile.Schedule != nil:
+			var err error
+			profileSchedule, err = schedule.New(profile.Schedule)
+			if err != nil {
+				return nil, fmt.Errorf("in profile %s: invalid schedule: %v", profile.Name, err)
+			}
+			if profile.Schedule.Jitter != nil {
+				jitter = profile.Schedule.Jitter.Duration
+			}
+		case deschedulingInterval > 0:
+			profileSchedule = schedule.Every(deschedulingInterval)
+		}
+		scheduler.Add(profile.Name, profileSchedule, jitter, now)
+	}
+	return scheduler, nil
+}
+
+// runScheduledCycles runs a descheduling cycle every time any of the profiles is due.
+// Only the profiles due run in the cycle.
+func (d *descheduler) runScheduledCycles(ctx context.Context, runCycle func()) {
+	for ctx.Err() == nil {
+		next := d.scheduler.NextRun()
+		if next.IsZero() {
+			klog.V(1).InfoS("No profile is scheduled to run anymore")
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		d.dueProfiles = d.scheduler.Due(time.Now())
+		klog.V(1).InfoS("Running the profiles due", "profiles", sets.List(d.dueProfiles))
+		runCycle()
 	}
 }
 
@@ -458,23 +849,65 @@ func Run(ctx context.Context, rs *options.DeschedulerServer) error {
 	defer span.End()
 	metrics.Register()
 
-	clientConnection := rs.ClientConnection
-	if rs.KubeconfigFile != "" && clientConnection.Kubeconfig == "" {
-		clientConnection.Kubeconfig = rs.KubeconfigFile
-	}
-	rsclient, eventClient, err := createClients(clientConnection)
+	deschedulerPolicy, evictionPolicyGroupVersion, err := setup(ctx, rs)
 	if err != nil {
 		return err
 	}
-	rs.Client = rsclient
-	rs.EventClient = eventClient
 
-	deschedulerPolicy, err := LoadPolicyConfig(rs.PolicyConfigFile, rs.Client, pluginregistry.PluginRegistry)
-	if err != nil {
+	runFn := func() error {
+		return RunDeschedulerStrategies(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion)
+	}
+
+	if err := validateLeaderElection(rs, deschedulerPolicy); err != nil {
+		span.AddEvent("Validation Failure", trace.WithAttributes(attribute.String("err", err.Error())))
 		return err
 	}
+
+	if rs.LeaderElection.LeaderElect && rs.DryRun {
+		klog.V(1).Info("Warning: DryRun is set to True. You need to disable it to use Leader Election.")
+	}
+
+	if rs.LeaderElection.LeaderElect && !rs.DryRun {
+		if err := NewLeaderElection(runFn, rs.Client, &rs.LeaderElection, ctx); err != nil {
+			span.AddEvent("Leader Election Failure", trace.WithAttributes(attribute.String("err", err.Error())))
+			return fmt.Errorf("leaderElection: %w", err)
+		}
+		return nil
+	}
+
+	return runFn()
+}
+
+// validateLeaderElection checks the descheduler runs in a loop when the leader election is enabled,
+// i.e. with a descheduling interval or with any of the profiles scheduled
+func validateLeaderElection(rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy) error {
+	if rs.LeaderElection.LeaderElect && rs.DeschedulingInterval.Seconds() == 0 && !hasProfileSchedules(deschedulerPolicy.Profiles) {
+		return fmt.Errorf("leaderElection must be used with deschedulingInterval or profile schedules")
+	}
+	return nil
+}
+
+// setup creates the clients and loads the policy. Returns the policy and the group version of the eviction API.
+func setup(ctx context.Context, rs *options.DeschedulerServer) (*api.DeschedulerPolicy, string, error) {
+	if rs.PolicyName != "" && rs.PolicyConfigFile != "" {
+		return nil, "", fmt.Errorf("--policy-config-file and --policy-name are mutually exclusive")
+	}
+	if err := setupClients(rs); err != nil {
+		return nil, "", err
+	}
+
+	var deschedulerPolicy *api.DeschedulerPolicy
+	var err error
+	if rs.PolicyName != "" {
+		deschedulerPolicy, err = loadPolicyResource(ctx, rs.DynamicClient, rs.Client, rs.PolicyName, rs.DryRun)
+	} else {
+		deschedulerPolicy, err = LoadPolicyConfig(rs.PolicyConfigFile, rs.Client, pluginregistry.PluginRegistry)
+	}
+	if err != nil {
+		return nil, "", err
+	}
 	if deschedulerPolicy == nil {
-		return fmt.Errorf("deschedulerPolicy is nil")
+		return nil, "", fmt.Errorf("deschedulerPolicy is nil")
 	}
 
 	// Add k8s compatibility warnings to logs
@@ -484,39 +917,48 @@ func Run(ctx context.Context, rs *options.DeschedulerServer) error {
 
 	evictionPolicyGroupVersion, err := eutils.SupportEviction(rs.Client)
 	if err != nil || len(evictionPolicyGroupVersion) == 0 {
-		return err
+		return nil, "", err
 	}
 
-	if (deschedulerPolicy.MetricsCollector != nil && deschedulerPolicy.MetricsCollector.Enabled) || metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.KubernetesMetrics] != nil {
-		metricsClient, err := client.CreateMetricsClient(clientConnection, "descheduler")
+	if usesMetricsServer(deschedulerPolicy) {
+		metricsClient, err := client.CreateMetricsClient(clientConnectionConfig(rs), "descheduler")
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 		rs.MetricsClient = metricsClient
 	}
+	return deschedulerPolicy, evictionPolicyGroupVersion, nil
+}
 
-	runFn := func() error {
-		return RunDeschedulerStrategies(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion)
-	}
+// usesMetricsServer tells whether the policy needs the metrics client of the metrics server
+func usesMetricsServer(deschedulerPolicy *api.DeschedulerPolicy) bool {
+	return (deschedulerPolicy.MetricsCollector != nil && deschedulerPolicy.MetricsCollector.Enabled) || metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.KubernetesMetrics] != nil
+}
 
-	if rs.LeaderElection.LeaderElect && rs.DeschedulingInterval.Seconds() == 0 {
-		span.AddEvent("Validation Failure", trace.WithAttributes(attribute.String("err", "leaderElection must be used with deschedulingInterval")))
-		return fmt.Errorf("leaderElection must be used with deschedulingInterval")
+// setupClients creates the clients of the cluster the descheduler runs against
+func setupClients(rs *options.DeschedulerServer) error {
+	clientConnection := clientConnectionConfig(rs)
+	rsclient, eventClient, err := createClients(clientConnection)
+	if err != nil {
+		return err
 	}
+	rs.Client = rsclient
+	rs.EventClient = eventClient
 
-	if rs.LeaderElection.LeaderElect && rs.DryRun {
-		klog.V(1).Info("Warning: DryRun is set to True. You need to disable it to use Leader Election.")
+	dynamicClient, err := client.CreateDynamicClient(clientConnection, "descheduler")
+	if err != nil {
+		return err
 	}
+	rs.DynamicClient = dynamicClient
+	return nil
+}
 
-	if rs.LeaderElection.LeaderElect && !rs.DryRun {
-		if err := NewLeaderElection(runFn, rsclient, &rs.LeaderElection, ctx); err != nil {
-			span.AddEvent("Leader Election Failure", trace.WithAttributes(attribute.String("err", err.Error())))
-			return fmt.Errorf("leaderElection: %w", err)
-		}
-		return nil
+func clientConnectionConfig(rs *options.DeschedulerServer) componentbaseconfig.ClientConnectionConfiguration {
+	clientConnection := rs.ClientConnection
+	if rs.KubeconfigFile != "" && clientConnection.Kubeconfig == "" {
+		clientConnection.Kubeconfig = rs.KubeconfigFile
 	}
-
-	return runFn()
+	return clientConnection
 }
 
 func validateVersionCompatibility(discovery discovery.DiscoveryInterface, deschedulerVersionInfo version.Info) error {
@@ -549,7 +991,9 @@ func validateVersionCompatibility(discovery discovery.DiscoveryInterface, desche
 	return nil
 }
 
+// podEvictionReactionFnc emulates the eviction API, including the checks of the pod disruption budgets
 func podEvictionReactionFnc(fakeClient *fakeclientset.Clientset) func(action core.Action) (bool, runtime.Object, error) {
+	evictionAPI := evictions.NewDryRunEvictionAPI(fakeClient.Tracker())
 	return func(action core.Action) (bool, runtime.Object, error) {
 		if action.GetSubresource() == "eviction" {
 			createAct, matched := action.(core.CreateActionImpl)
@@ -560,10 +1004,7 @@ func podEvictionReactionFnc(fakeClient *fakeclientset.Clientset) func(action cor
 			if !matched {
 				return false, nil, fmt.Errorf("unable to convert action object into *policy.Eviction")
 			}
-			if err := fakeClient.Tracker().Delete(action.GetResource(), eviction.GetNamespace(), eviction.GetName()); err != nil {
-				return false, nil, fmt.Errorf("unable to delete pod %v/%v: %v", eviction.GetNamespace(), eviction.GetName(), err)
-			}
-			return true, nil, nil
+			return true, nil, evictionAPI.Evict(eviction)
 		}
 		// fallback to the default reactor
 		return false, nil, nil
@@ -578,18 +1019,36 @@ const (
 	secretReconciliation
 )
 
+// prometheusTokenReconciliation tells how the authentication token of the prometheus metrics provider is reconciled
+func prometheusTokenReconciliation(deschedulerPolicy *api.DeschedulerPolicy) tokenReconciliation {
+	prometheusProvider := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.PrometheusMetrics]
+	if prometheusProvider == nil || prometheusProvider.Prometheus == nil || prometheusProvider.Prometheus.URL == "" {
+		return noReconciliation
+	}
+	if prometheusProvider.Prometheus.AuthToken != nil {
+		// Will get reconciled
+		return secretReconciliation
+	}
+	// Use the sa token and assume it has the sufficient permissions to authenticate
+	return inClusterReconciliation
+}
+
+// cycleFunc runs a single descheduling cycle over the given nodes
+type cycleFunc func(ctx context.Context, d *descheduler, nodes []*v1.Node) error
+
 func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string) error {
+	return runDeschedulerStrategies(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion, func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+		return d.runDeschedulerLoop(ctx, nodes)
+	})
+}
+
+func runDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string, cycle cycleFunc) error {
 	var span trace.Span
 	ctx, span = tracing.Tracer().Start(ctx, "RunDeschedulerStrategies")
 	defer span.End()
 
 	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields))
 
-	var nodeSelector string
-	if deschedulerPolicy.NodeSelector != nil {
-		nodeSelector = *deschedulerPolicy.NodeSelector
-	}
-
 	var eventClient clientset.Interface
 	if rs.DryRun {
 		eventClient = fakeclientset.NewSimpleClientset()
@@ -600,18 +1059,10 @@ func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer
 	defer eventBroadcaster.Shutdown()
 
 	var namespacedSharedInformerFactory informers.SharedInformerFactory
-	metricProviderTokenReconciliation := noReconciliation
-
-	prometheusProvider := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.PrometheusMetrics]
-	if prometheusProvider != nil && prometheusProvider.Prometheus != nil && prometheusProvider.Prometheus.URL != "" {
-		if prometheusProvider.Prometheus.AuthToken != nil {
-			// Will get reconciled
-			namespacedSharedInformerFactory = informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields), informers.WithNamespace(prometheusProvider.Prometheus.AuthToken.SecretReference.Namespace))
-			metricProviderTokenReconciliation = secretReconciliation
-		} else {
-			// Use the sa token and assume it has the sufficient permissions to authenticate
-			metricProviderTokenReconciliation = inClusterReconciliation
-		}
+	metricProviderTokenReconciliation := prometheusTokenReconciliation(deschedulerPolicy)
+	if metricProviderTokenReconciliation == secretReconciliation {
+		prometheusProvider := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.PrometheusMetrics]
+		namespacedSharedInformerFactory = informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields), informers.WithNamespace(prometheusProvider.Prometheus.AuthToken.SecretReference.Namespace))
 	}
 
 	descheduler, err := newDescheduler(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion, eventRecorder, sharedInformerFactory, namespacedSharedInformerFactory)
@@ -619,6 +1070,7 @@ func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer
 		span.AddEvent("Failed to create new descheduler", trace.WithAttributes(attribute.String("err", err.Error())))
 		return err
 	}
+	defer descheduler.closeProfiles()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -628,6 +1080,10 @@ func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer
 	}
 
 	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+	if descheduler.dynamicInformerFactory != nil {
+		descheduler.dynamicInformerFactory.Start(ctx.Done())
+		descheduler.dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+	}
 	descheduler.podEvictor.WaitForEventHandlersSync(ctx)
 	if metricProviderTokenReconciliation == secretReconciliation {
 		namespacedSharedInformerFactory.WaitForCacheSync(ctx.Done())
@@ -651,7 +1107,21 @@ func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer
 		go descheduler.runAuthenticationSecretReconciler(ctx)
 	}
 
-	wait.NonSlidingUntil(func() {
+	if rs.PolicyName != "" {
+		descheduler.policyResource = newPolicyResource(rs.DynamicClient, rs.PolicyName, rs.DryRun)
+		descheduler.policyResource.informerFactory.Start(ctx.Done())
+		descheduler.policyResource.informerFactory.WaitForCacheSync(ctx.Done())
+	}
+
+	// the policy is reloaded only when the descheduler runs more than one cycle
+	reloadPolicy := !rs.DisablePolicyReload && (rs.DeschedulingInterval > 0 || descheduler.scheduler != nil)
+
+	runCycle := func() {
+		if reloadPolicy && descheduler.policyResource != nil {
+			descheduler.reconcilePolicyResource(ctx)
+		} else if reloadPolicy {
+			descheduler.reloadPolicy(ctx)
+		}
 		if metricProviderTokenReconciliation == inClusterReconciliation {
 			// Read the sa token and assume it has the sufficient permissions to authenticate
 			if err := descheduler.reconcileInClusterSAToken(); err != nil {
@@ -664,6 +1134,10 @@ func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer
 		sCtx, sSpan := tracing.Tracer().Start(ctx, "NonSlidingUntil")
 		defer sSpan.End()
 
+		var nodeSelector string
+		if descheduler.deschedulerPolicy.NodeSelector != nil {
+			nodeSelector = *descheduler.deschedulerPolicy.NodeSelector
+		}
 		nodes, err := nodeutil.ReadyNodes(sCtx, rs.Client, descheduler.sharedInformerFactory.Core().V1().Nodes().Lister(), nodeSelector)
 		if err != nil {
 			sSpan.AddEvent("Failed to detect ready nodes", trace.WithAttributes(attribute.String("err", err.Error())))
@@ -671,13 +1145,25 @@ func RunDeschedulerStrategies(ctx context.Context, rs *options.DeschedulerServer
 			cancel()
 			return
 		}
-		err = descheduler.runDeschedulerLoop(sCtx, nodes)
+		err = cycle(sCtx, descheduler, nodes)
 		if err != nil {
 			sSpan.AddEvent("Failed to run descheduler loop", trace.WithAttributes(attribute.String("err", err.Error())))
 			klog.Error(err)
 			cancel()
 			return
 		}
+		if descheduler.policyResource != nil {
+			descheduler.policyResource.reportCycle(ctx, descheduler.reports, time.Now())
+		}
+	}
+
+	if descheduler.scheduler != nil {
+		descheduler.runScheduledCycles(ctx, runCycle)
+		return nil
+	}
+
+	wait.NonSlidingUntil(func() {
+		runCycle()
 		// If there was no interval specified, send a signal to the stopChannel to end the wait.Until loop after 1 iteration
 		if rs.DeschedulingInterval.Seconds() == 0 {
 			cancel()
@@ -6,20 +6,28 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+
 	v1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apiversion "k8s.io/apimachinery/pkg/version"
 	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/klog/v2"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -31,11 +39,16 @@ import (
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/features"
+	fakeplugin "sigs.k8s.io/descheduler/pkg/framework/fake/plugin"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodshavingtoomanyrestarts"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodetaints"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/utils"
 	deschedulerversion "sigs.k8s.io/descheduler/pkg/version"
 	"sigs.k8s.io/descheduler/test"
@@ -69,6 +82,8 @@ func initPluginRegistry() {
 	pluginregistry.Register(defaultevictor.PluginName, defaultevictor.New, &defaultevictor.DefaultEvictor{}, &defaultevictor.DefaultEvictorArgs{}, defaultevictor.ValidateDefaultEvictorArgs, defaultevictor.SetDefaults_DefaultEvictorArgs, pluginregistry.PluginRegistry)
 	pluginregistry.Register(removepodsviolatingnodetaints.PluginName, removepodsviolatingnodetaints.New, &removepodsviolatingnodetaints.RemovePodsViolatingNodeTaints{}, &removepodsviolatingnodetaints.RemovePodsViolatingNodeTaintsArgs{}, removepodsviolatingnodetaints.ValidateRemovePodsViolatingNodeTaintsArgs, removepodsviolatingnodetaints.SetDefaults_RemovePodsViolatingNodeTaintsArgs, pluginregistry.PluginRegistry)
 	pluginregistry.Register(nodeutilization.LowNodeUtilizationPluginName, nodeutilization.NewLowNodeUtilization, &nodeutilization.LowNodeUtilization{}, &nodeutilization.LowNodeUtilizationArgs{}, nodeutilization.ValidateLowNodeUtilizationArgs, nodeutilization.SetDefaults_LowNodeUtilizationArgs, pluginregistry.PluginRegistry)
+	pluginregistry.Register(podlifetime.PluginName, podlifetime.New, &podlifetime.PodLifeTime{}, &podlifetime.PodLifeTimeArgs{}, podlifetime.ValidatePodLifeTimeArgs, podlifetime.SetDefaults_PodLifeTimeArgs, pluginregistry.PluginRegistry)
+	pluginregistry.Register(removepodshavingtoomanyrestarts.PluginName, removepodshavingtoomanyrestarts.New, &removepodshavingtoomanyrestarts.RemovePodsHavingTooManyRestarts{}, &removepodshavingtoomanyrestarts.RemovePodsHavingTooManyRestartsArgs{}, removepodshavingtoomanyrestarts.ValidateRemovePodsHavingTooManyRestartsArgs, removepodshavingtoomanyrestarts.SetDefaults_RemovePodsHavingTooManyRestartsArgs, pluginregistry.PluginRegistry)
 }
 
 func removePodsViolatingNodeTaintsPolicy() *api.DeschedulerPolicy {
@@ -382,6 +397,54 @@ func TestRootCancelWithNoInterval(t *testing.T) {
 	}
 }
 
+func TestValidateLeaderElection(t *testing.T) {
+	scheduled := removePodsViolatingNodeTaintsPolicy()
+	scheduled.Profiles[0].Schedule = &api.ProfileSchedule{Cron: "0 2 * * *"}
+
+	tests := []struct {
+		description          string
+		leaderElect          bool
+		deschedulingInterval time.Duration
+		policy               *api.DeschedulerPolicy
+		expectedErr          bool
+	}{
+		{
+			description: "leader election disabled",
+			policy:      removePodsViolatingNodeTaintsPolicy(),
+		},
+		{
+			description:          "leader election with descheduling interval",
+			leaderElect:          true,
+			deschedulingInterval: time.Minute,
+			policy:               removePodsViolatingNodeTaintsPolicy(),
+		},
+		{
+			description: "leader election with profile schedules only",
+			leaderElect: true,
+			policy:      scheduled,
+		},
+		{
+			description: "leader election running once",
+			leaderElect: true,
+			policy:      removePodsViolatingNodeTaintsPolicy(),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			rs, err := options.NewDeschedulerServer()
+			if err != nil {
+				t.Fatalf("Unable to initialize server: %v", err)
+			}
+			rs.LeaderElection.LeaderElect = tc.leaderElect
+			rs.DeschedulingInterval = tc.deschedulingInterval
+			if err := validateLeaderElection(rs, tc.policy); (err != nil) != tc.expectedErr {
+				t.Errorf("Expected error: %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
 func TestValidateVersionCompatibility(t *testing.T) {
 	type testCase struct {
 		name               string
@@ -544,6 +607,255 @@ func TestPodEvictorReset(t *testing.T) {
 	}
 }
 
+func TestDryRunPodDisruptionBudgets(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	objects := []runtime.Object{node1, node2}
+	for i := 0; i < 4; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			pod.Labels = map[string]string{"app": "web"}
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+		}))
+	}
+	// the budget carries no status, it is computed from the healthy pods in the dry run mode
+	objects = append(objects, &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: policy.PodDisruptionBudgetSpec{
+			MinAvailable: utilptr.To(intstr.FromInt32(3)),
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	})
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	rs, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), removePodsViolatingNodeTaintsPolicy(), nil, objects...)
+	defer cancel()
+	rs.DryRun = true
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	// every cycle starts over from the copy of the cluster
+	for i := 0; i < 2; i++ {
+		if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+			t.Fatalf("Unable to run a descheduling loop: %v", err)
+		}
+		if descheduler.podEvictor.TotalEvicted() != 1 || len(evictedPods) != 0 {
+			t.Fatalf("Expected a single fake eviction allowed by the budget, got %v fake and %v real evictions instead", descheduler.podEvictor.TotalEvicted(), len(evictedPods))
+		}
+	}
+}
+
+func TestDryRunRollingEvictions(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	objects := []runtime.Object{node1, node2}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+		}))
+	}
+
+	internalDeschedulerPolicy := removePodsViolatingNodeTaintsPolicy()
+	internalDeschedulerPolicy.RollingEvictions = &api.RollingEvictions{}
+	ctxCancel, cancel := context.WithCancel(ctx)
+	rs, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), internalDeschedulerPolicy, nil, objects...)
+	defer cancel()
+	rs.DryRun = true
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	// every cycle starts over from the copy of the cluster in which the ready replicas recovered
+	for i := 0; i < 2; i++ {
+		if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+			t.Fatalf("Unable to run a descheduling loop: %v", err)
+		}
+		if descheduler.podEvictor.TotalEvicted() != 1 || len(evictedPods) != 0 {
+			t.Fatalf("Expected a single fake eviction in cycle %v, got %v fake and %v real evictions instead", i, descheduler.podEvictor.TotalEvicted(), len(evictedPods))
+		}
+	}
+}
+
+func TestProfilesReusedAcrossCycles(t *testing.T) {
+	initPluginRegistry()
+
+	fakeLifecyclePlugin := &fakeplugin.FakeLifecyclePlugin{PluginName: "FakeLifecyclePlugin"}
+	pluginregistry.Register(
+		fakeLifecyclePlugin.PluginName,
+		fakeplugin.NewFakeLifecyclePluginFncFromFake(fakeLifecyclePlugin),
+		&fakeplugin.FakeLifecyclePlugin{},
+		&fakeplugin.FakeLifecyclePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	internalDeschedulerPolicy := &api.DeschedulerPolicy{
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name: "Profile",
+				PluginConfigs: []api.PluginConfig{
+					{
+						Name: fakeLifecyclePlugin.PluginName,
+						Args: &fakeplugin.FakeLifecyclePluginArgs{},
+					},
+				},
+				Plugins: api.Plugins{
+					Deschedule: api.PluginSet{
+						Enabled: []string{fakeLifecyclePlugin.PluginName},
+					},
+				},
+			},
+		},
+	}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rs, descheduler, _ := initDescheduler(t, ctxCancel, initFeatureGates(), internalDeschedulerPolicy, nil, node1, node2)
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	// the dry run mode works over a new copy of the cluster in every cycle
+	rs.DryRun = true
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	descheduler.closeProfiles()
+
+	expectedCalls := []string{"New", "Init", "BeforeCycle", "Deschedule", "AfterCycle", "BeforeCycle", "Deschedule", "AfterCycle", "Close"}
+	if diff := cmp.Diff(expectedCalls, fakeLifecyclePlugin.Calls); diff != "" {
+		t.Errorf("unexpected plugin invocations (-want +got):\n%s", diff)
+	}
+}
+
+func TestPluginRequiredResources(t *testing.T) {
+	initPluginRegistry()
+
+	widgetsgvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	pdbsgvr := policy.SchemeGroupVersion.WithResource("poddisruptionbudgets")
+	fakeResourcesPlugin := &fakeplugin.FakeResourcesPlugin{
+		PluginName:       "FakeResourcesPlugin",
+		Resources:        []schema.GroupVersionResource{pdbsgvr, widgetsgvr},
+		DynamicResources: []schema.GroupVersionResource{widgetsgvr},
+	}
+	pluginregistry.Register(
+		fakeResourcesPlugin.PluginName,
+		fakeplugin.NewFakeResourcesPluginFncFromFake(fakeResourcesPlugin),
+		fakeResourcesPlugin,
+		&fakeplugin.FakeResourcesPluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	widget := &unstructured.Unstructured{}
+	widget.SetAPIVersion("example.com/v1")
+	widget.SetKind("Widget")
+	widget.SetNamespace("default")
+	widget.SetName("w1")
+
+	client := fakeclientset.NewSimpleClientset(node1, node2)
+	fakeDiscovery, _ := client.Discovery().(*fakediscovery.FakeDiscovery)
+	fakeDiscovery.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "example.com/v1",
+			APIResources: []metav1.APIResource{{Name: "widgets", Namespaced: true, Kind: "Widget"}},
+		},
+	}
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.Client = client
+	rs.EventClient = fakeclientset.NewSimpleClientset()
+	rs.DynamicClient = fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{widgetsgvr: "WidgetList"}, widget)
+	rs.DefaultFeatureGates = initFeatureGates()
+
+	internalDeschedulerPolicy := &api.DeschedulerPolicy{
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name: "Profile",
+				PluginConfigs: []api.PluginConfig{
+					{
+						Name: fakeResourcesPlugin.PluginName,
+						Args: &fakeplugin.FakeResourcesPluginArgs{},
+					},
+				},
+				Plugins: api.Plugins{
+					Deschedule: api.PluginSet{
+						Enabled: []string{fakeResourcesPlugin.PluginName},
+					},
+				},
+			},
+		},
+	}
+
+	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields))
+	eventBroadcaster, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+	defer eventBroadcaster.Shutdown()
+
+	descheduler, err := newDescheduler(ctx, rs, internalDeschedulerPolicy, "v1", eventRecorder, sharedInformerFactory, nil)
+	if err != nil {
+		t.Fatalf("Unable to create a descheduler instance: %v", err)
+	}
+
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+	descheduler.dynamicInformerFactory.Start(ctx.Done())
+	descheduler.dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	var typedResources []string
+	for resource := range descheduler.ir.resourceToInformer {
+		typedResources = append(typedResources, resource.Resource)
+	}
+	sort.Strings(typedResources)
+	if diff := cmp.Diff([]string{"nodes", "poddisruptionbudgets", "pods"}, typedResources); diff != "" {
+		t.Errorf("Unexpected typed informers (-want +got):\n%s", diff)
+	}
+	if _, ok := descheduler.ir.dynamicResourceToInformer[widgetsgvr]; !ok || len(descheduler.ir.dynamicResourceToInformer) != 1 {
+		t.Errorf("Expected a single dynamic informer for %v, got %v", widgetsgvr, descheduler.ir.dynamicResourceToInformer)
+	}
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	// the dynamic resources are mirrored into the dry run cluster
+	rs.DryRun = true
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	if diff := cmp.Diff([][]string{{"default/w1"}, {"default/w1"}}, fakeResourcesPlugin.Listed); diff != "" {
+		t.Errorf("Unexpected objects listed by the plugin (-want +got):\n%s", diff)
+	}
+}
+
 func checkTotals(t *testing.T, ctx context.Context, descheduler *descheduler, totalEvictionRequests, totalEvicted uint) {
 	if total := descheduler.podEvictor.TotalEvictionRequests(); total != totalEvictionRequests {
 		t.Fatalf("Expected %v total eviction requests, got %v instead", totalEvictionRequests, total)
@@ -790,6 +1102,442 @@ func TestDeschedulingLimits(t *testing.T) {
 	}
 }
 
+func TestConcurrentDeschedulingLimits(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, taintNodeNoSchedule)
+	node3 := test.BuildTestNode("n3", 2000, 3000, 10, taintNodeNoSchedule)
+	nodes := []*v1.Node{node1, node2, node3}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2, node3}
+	for _, node := range nodes {
+		for i := 0; i < 4; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%v-p%v", node.Name, i), 100, 0, node.Name, func(pod *v1.Pod) {
+				pod.ObjectMeta.OwnerReferences = ownerRef1
+			}))
+		}
+	}
+
+	// independent profiles evicting the same pods share the limits
+	policy := removePodsViolatingNodeTaintsPolicy()
+	for i := 1; i < 3; i++ {
+		profile := *policy.Profiles[0].DeepCopy()
+		profile.Name = fmt.Sprintf("Profile%v", i)
+		policy.Profiles = append(policy.Profiles, profile)
+	}
+	policy.Concurrency = utilptr.To[uint](4)
+	policy.MaxNoOfPodsToEvictPerNode = utilptr.To[uint](2)
+	policy.MaxNoOfPodsToEvictTotal = utilptr.To[uint](5)
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), policy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	if total := descheduler.podEvictor.TotalEvicted(); total != 5 {
+		t.Fatalf("Expected %v evictions in total, got %v instead", 5, total)
+	}
+	for _, node := range nodes {
+		if evicted := descheduler.podEvictor.NodeEvicted(node); evicted > 2 {
+			t.Fatalf("Expected at most %v evictions on node %v, got %v instead", 2, node.Name, evicted)
+		}
+	}
+}
+
+func TestProfileNodeSelectorAndLimits(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	withPool := func(pool string) func(*v1.Node) {
+		return func(node *v1.Node) {
+			taintNodeNoSchedule(node)
+			node.Labels["pool"] = pool
+		}
+	}
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, withPool("batch"))
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, withPool("stateful"))
+	node3 := test.BuildTestNode("n3", 2000, 3000, 10, withPool("batch"))
+	node4 := test.BuildTestNode("n4", 2000, 3000, 10, withPool("stateful"))
+	// the only node of the gpu pool
+	node5 := test.BuildTestNode("n5", 2000, 3000, 10, withPool("gpu"))
+	nodes := []*v1.Node{node1, node2, node3, node4, node5}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2, node3, node4, node5}
+	for _, node := range []*v1.Node{node1, node2, node5} {
+		for i := 0; i < 3; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%v-p%v", node.Name, i), 100, 0, node.Name, func(pod *v1.Pod) {
+				pod.ObjectMeta.OwnerReferences = ownerRef1
+			}))
+		}
+	}
+
+	policy := removePodsViolatingNodeTaintsPolicy()
+	batch := *policy.Profiles[0].DeepCopy()
+	batch.Name = "batch"
+	batch.NodeSelector = utilptr.To("pool=batch")
+	batch.MaxNoOfPodsToEvictPerNode = utilptr.To[uint](2)
+	stateful := *policy.Profiles[0].DeepCopy()
+	stateful.Name = "stateful"
+	stateful.NodeSelector = utilptr.To("pool=stateful")
+	stateful.MaxNoOfPodsToEvictTotal = utilptr.To[uint](1)
+	gpu := *policy.Profiles[0].DeepCopy()
+	gpu.Name = "gpu"
+	gpu.NodeSelector = utilptr.To("pool=gpu")
+	policy.Profiles = []api.DeschedulerProfile{batch, stateful, gpu}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), policy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	// evicting from a pool of a single node causes service disruption, the gpu profile is skipped
+	for node, expected := range map[*v1.Node]uint{node1: 2, node2: 1, node5: 0} {
+		if evicted := descheduler.podEvictor.NodeEvicted(node); evicted != expected {
+			t.Errorf("Expected %v evictions on node %v, got %v instead", expected, node.Name, evicted)
+		}
+	}
+	for profile, expected := range map[string]uint{"batch": 2, "stateful": 1, "gpu": 0} {
+		if evicted := descheduler.podEvictor.ProfileEvicted(profile); evicted != expected {
+			t.Errorf("Expected %v evictions by %v profile, got %v instead", expected, profile, evicted)
+		}
+	}
+}
+
+func TestProfileSummaryEvents(t *testing.T) {
+	initPluginRegistry()
+	t.Setenv(podNameEnv, "descheduler")
+	t.Setenv(podNamespaceEnv, "kube-system")
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2}
+	for i := 0; i < 2; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = ownerRef1
+		}))
+	}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), removePodsViolatingNodeTaintsPolicy(), nil, objects...)
+	eventRecorder := events.NewFakeRecorder(10)
+	descheduler.eventRecorder = eventRecorder
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	close(eventRecorder.Events)
+	var events []string
+	for event := range eventRecorder.Events {
+		if strings.Fields(event)[1] == "ProfileFinished" {
+			events = append(events, event)
+		}
+	}
+	expectedEvents := []string{"Normal ProfileFinished profile Profile evicted 2 pods and skipped 0 pods, plugins finished with map[Success:1]"}
+	if diff := cmp.Diff(expectedEvents, events); diff != "" {
+		t.Errorf("Unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestEvictionPlanning(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2}
+	for namespace, count := range map[string]int{"busy": 4, "quiet": 2} {
+		for i := 0; i < count; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%v-p%v", namespace, i), 100, 0, node1.Name, func(pod *v1.Pod) {
+				pod.Namespace = namespace
+				pod.ObjectMeta.OwnerReferences = ownerRef1
+			}))
+		}
+	}
+
+	policy := removePodsViolatingNodeTaintsPolicy()
+	policy.MaxNoOfPodsToEvictTotal = utilptr.To[uint](3)
+	policy.EvictionPlanning = &api.EvictionPlanning{
+		NamespaceWeights: map[string]uint{"quiet": 2},
+	}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), policy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	// the quiet namespace gets two thirds of the evictions
+	evicted := map[string]int{}
+	for _, pod := range evictedPods {
+		evicted[strings.Split(pod, "-")[0]]++
+	}
+	if diff := cmp.Diff(map[string]int{"busy": 1, "quiet": 2}, evicted); diff != "" {
+		t.Errorf("Unexpected evictions per namespace (-want +got):\n%s", diff)
+	}
+	if len(descheduler.reports) != 1 {
+		t.Fatalf("Expected a single report, got %v", len(descheduler.reports))
+	}
+	report := descheduler.reports[0]
+	if report.Evicted() != 3 {
+		t.Errorf("Expected the report to include 3 planned evictions, got %v", report.Evicted())
+	}
+	if codes := report.Codes(); codes[frameworktypes.LimitReached] != 1 {
+		t.Errorf("Expected the plugin to reach the eviction limit, got %v", codes)
+	}
+}
+
+func TestEvictionPlanningScores(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2}
+	for _, p := range []struct {
+		name       string
+		ageSeconds int
+		restarts   int32
+	}{
+		{name: "old", ageSeconds: 6000},
+		{name: "aged", ageSeconds: 1300},
+		{name: "crashing", ageSeconds: 700, restarts: 40},
+		{name: "restarting", ageSeconds: 100, restarts: 20},
+	} {
+		objects = append(objects, test.BuildTestPod(p.name, 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = ownerRef1
+			pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Duration(p.ageSeconds) * time.Second))
+			pod.Status.ContainerStatuses = []v1.ContainerStatus{{RestartCount: p.restarts}}
+		}))
+	}
+
+	policy := &api.DeschedulerPolicy{
+		MaxNoOfPodsToEvictTotal: utilptr.To[uint](2),
+		EvictionPlanning:        &api.EvictionPlanning{},
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name: "Profile",
+				PluginConfigs: []api.PluginConfig{
+					{
+						Name: podlifetime.PluginName,
+						Args: &podlifetime.PodLifeTimeArgs{MaxPodLifeTimeSeconds: utilptr.To[uint](600)},
+					},
+					{
+						Name: removepodshavingtoomanyrestarts.PluginName,
+						Args: &removepodshavingtoomanyrestarts.RemovePodsHavingTooManyRestartsArgs{PodRestartThreshold: 10},
+					},
+					{
+						Name: "DefaultEvictor",
+						Args: &defaultevictor.DefaultEvictorArgs{},
+					},
+				},
+				Plugins: api.Plugins{
+					Filter: api.PluginSet{
+						Enabled: []string{
+							"DefaultEvictor",
+						},
+					},
+					Deschedule: api.PluginSet{
+						Enabled: []string{
+							podlifetime.PluginName,
+							removepodshavingtoomanyrestarts.PluginName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), policy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	// in the proposal order the aged pod would come second, the crashing pod
+	// is further past the restart threshold than the aged pod is past the max
+	// lifetime
+	sort.Strings(evictedPods)
+	if diff := cmp.Diff([]string{"crashing", "old"}, evictedPods); diff != "" {
+		t.Errorf("Unexpected evicted pods (-want +got):\n%s", diff)
+	}
+}
+
+func TestPlanAndApply(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = ownerRef1
+		}))
+	}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rs, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), removePodsViolatingNodeTaintsPolicy(), nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	rs.DryRun = true
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	file := descheduler.evictionPlan(nodes)
+	if len(evictedPods) != 0 {
+		t.Fatalf("Expected no pod evicted while planning, got %v", evictedPods)
+	}
+
+	sort.Slice(file.Entries, func(i, j int) bool {
+		return file.Entries[i].Name < file.Entries[j].Name
+	})
+	var planned []string
+	for _, entry := range file.Entries {
+		planned = append(planned, entry.Name)
+		if entry.Node != node1.Name || entry.TargetNode != node2.Name || entry.Profile != "Profile" || entry.Plugin != removepodsviolatingnodetaints.PluginName {
+			t.Errorf("Unexpected plan entry %+v", entry)
+		}
+	}
+	if diff := cmp.Diff([]string{"p0", "p1", "p2"}, planned); diff != "" {
+		t.Fatalf("Unexpected planned pods (-want +got):\n%s", diff)
+	}
+
+	// the second pod got recreated and the third one is planned by a profile no longer in the policy
+	file.Entries[1].UID = "recreated"
+	file.Entries[2].Profile = "Removed"
+	file.Entries = append(file.Entries, plan.Entry{Namespace: "default", Name: "gone", Node: node1.Name, Profile: "Profile"})
+
+	rs.DryRun = false
+	results := descheduler.applyPlan(ctx, file)
+	var errs []string
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, result.Err.Error())
+		}
+	}
+	expectedErrs := []string{
+		fmt.Sprintf("pod was recreated, its UID is %s instead of recreated", objects[3].(*v1.Pod).UID),
+		"profile Removed is not in the policy",
+		"pod no longer exists",
+	}
+	if diff := cmp.Diff(expectedErrs, errs); diff != "" {
+		t.Errorf("Unexpected skipped entries (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"p0"}, evictedPods); diff != "" {
+		t.Errorf("Unexpected evicted pods (-want +got):\n%s", diff)
+	}
+}
+
+func TestScheduledProfiles(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	withPool := func(pool string) func(*v1.Node) {
+		return func(node *v1.Node) {
+			taintNodeNoSchedule(node)
+			node.Labels["pool"] = pool
+		}
+	}
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, withPool("batch"))
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, withPool("stateful"))
+	nodes := []*v1.Node{node1, node2}
+
+	ownerRef1 := test.GetReplicaSetOwnerRefList()
+	objects := []runtime.Object{node1, node2}
+	for _, node := range nodes {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("%v-p", node.Name), 100, 0, node.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = ownerRef1
+		}))
+	}
+	// profiles selecting a single node are skipped
+	node3 := test.BuildTestNode("n3", 2000, 3000, 10, withPool("batch"))
+	node4 := test.BuildTestNode("n4", 2000, 3000, 10, withPool("stateful"))
+	nodes = append(nodes, node3, node4)
+	objects = append(objects, node3, node4)
+
+	policy := removePodsViolatingNodeTaintsPolicy()
+	batch := *policy.Profiles[0].DeepCopy()
+	batch.Name = "batch"
+	batch.NodeSelector = utilptr.To("pool=batch")
+	stateful := *policy.Profiles[0].DeepCopy()
+	stateful.Name = "stateful"
+	stateful.NodeSelector = utilptr.To("pool=stateful")
+	stateful.Schedule = &api.ProfileSchedule{Cron: "0 2 * * *"}
+	policy.Profiles = []api.DeschedulerProfile{batch, stateful}
+
+	ctxCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, descheduler, client := initDescheduler(t, ctxCancel, initFeatureGates(), policy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if descheduler.scheduler == nil {
+		t.Fatalf("Expected the profiles to be scheduled")
+	}
+	// the profile without a schedule runs right away, the nightly one is not due yet
+	descheduler.dueProfiles = descheduler.scheduler.Due(time.Now())
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+
+	for profile, expected := range map[string]uint{"batch": 1, "stateful": 0} {
+		if evicted := descheduler.podEvictor.ProfileEvicted(profile); evicted != expected {
+			t.Errorf("Expected %v evictions by %v profile, got %v instead", expected, profile, evicted)
+		}
+	}
+	if next := descheduler.scheduler.NextRun(); next.IsZero() || next.Sub(time.Now()) > 24*time.Hour {
+		t.Errorf("Expected the stateful profile to be due within a day, got %v instead", next)
+	}
+}
+
 func TestLoadAwareDescheduling(t *testing.T) {
 	initPluginRegistry()
 
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+	"sigs.k8s.io/descheduler/pkg/descheduler/snapshot"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// DiffPolicies runs a single descheduling cycle of the old and of the new policy over the same
+// cluster state and compares the evictions and the node classification of the two.
+// The cluster state is read from the snapshot directory when set, otherwise it is listed
+// from the cluster once so both policies run over the same objects.
+// Each policy runs in the dry run mode over its own fake cluster.
+func DiffPolicies(ctx context.Context, rs *options.DeschedulerServer, oldPolicyFile, newPolicyFile, snapshotDir string) (*simulation.PolicyDiff, error) {
+	var objects []runtime.Object
+	var policyClient clientset.Interface
+	if snapshotDir != "" {
+		var err error
+		if objects, err = snapshot.Load(snapshotDir); err != nil {
+			return nil, err
+		}
+		policyClient = fakeclientset.NewSimpleClientset(objects...)
+	} else {
+		if err := setupClients(rs); err != nil {
+			return nil, err
+		}
+		policyClient = rs.Client
+	}
+
+	policyFiles := []string{oldPolicyFile, newPolicyFile}
+	var policies []*api.DeschedulerPolicy
+	for _, policyFile := range policyFiles {
+		deschedulerPolicy, err := LoadPolicyConfig(policyFile, policyClient, pluginregistry.PluginRegistry)
+		if err != nil {
+			return nil, err
+		}
+		if deschedulerPolicy == nil {
+			return nil, fmt.Errorf("policy config file not specified")
+		}
+		policies = append(policies, deschedulerPolicy)
+	}
+
+	if snapshotDir == "" {
+		resources := append(informedResources(policies[0]), informedResources(policies[1])...)
+		var err error
+		if objects, err = clusterObjects(ctx, rs.Client, append(resources, snapshotResources...)); err != nil {
+			return nil, err
+		}
+	}
+
+	var reports [][]*frameworktypes.CycleReport
+	for idx, deschedulerPolicy := range policies {
+		var cycleReports []*frameworktypes.CycleReport
+		var cycleErr error
+		ran := false
+		err := runOverObjects(ctx, rs, objects, deschedulerPolicy, func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+			ran = true
+			if cycleErr = d.runDeschedulerLoop(ctx, nodes); cycleErr != nil {
+				return cycleErr
+			}
+			cycleReports = d.reports
+			return nil
+		})
+		if err == nil {
+			err = cycleErr
+		}
+		if err == nil && !ran {
+			err = fmt.Errorf("the descheduling cycle did not run")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to run the policy %s: %v", policyFiles[idx], err)
+		}
+		reports = append(reports, cycleReports)
+	}
+	return simulation.Diff(reports[0], reports[1]), nil
+}
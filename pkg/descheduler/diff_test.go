@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+	"sigs.k8s.io/descheduler/pkg/descheduler/snapshot"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodetaints"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestDiffPolicies(t *testing.T) {
+	initPluginRegistry()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	node3 := test.BuildTestNode("n3", 2000, 3000, 10, nil)
+	objects := []runtime.Object{node1, node2, node3}
+	for i := 0; i < 8; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, test.SetRSOwnerRef))
+	}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("q%v", i), 100, 0, node3.Name, test.SetRSOwnerRef))
+	}
+
+	dir := t.TempDir()
+	snapshotDir := filepath.Join(dir, "snapshot")
+	if err := snapshot.Write(snapshotDir, objects); err != nil {
+		t.Fatalf("Unable to write the snapshot: %v", err)
+	}
+	policies := map[string]string{
+		"old.yaml": `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: Taints
+    pluginConfig:
+      - name: "RemovePodsViolatingNodeTaints"
+      - name: "DefaultEvictor"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsViolatingNodeTaints"
+`,
+		"new.yaml": `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: Balance
+    pluginConfig:
+      - name: "LowNodeUtilization"
+        args:
+          thresholds:
+            pods: 20
+          targetThresholds:
+            pods: 70
+      - name: "DefaultEvictor"
+    plugins:
+      balance:
+        enabled:
+          - "LowNodeUtilization"
+`,
+	}
+	for name, policy := range policies {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(policy), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.DefaultFeatureGates = initFeatureGates()
+	diff, err := DiffPolicies(context.Background(), rs, filepath.Join(dir, "old.yaml"), filepath.Join(dir, "new.yaml"), snapshotDir)
+	if err != nil {
+		t.Fatalf("Unable to compare the policies: %v", err)
+	}
+
+	// both policies evict from the tainted node only, the old one all of its pods
+	if len(diff.OnlyNew) != 0 {
+		t.Errorf("Expected no pod evicted only by the new policy, got %+v", diff.OnlyNew)
+	}
+	if diff.Both == 0 {
+		t.Errorf("Expected pods evicted by both policies")
+	}
+	if len(diff.OnlyOld)+diff.Both != 8 {
+		t.Errorf("Expected 8 pods evicted by the old policy, got %d only by the old one and %d by both", len(diff.OnlyOld), diff.Both)
+	}
+	for _, entry := range diff.OnlyOld {
+		if entry.Profile != "Taints" || entry.Plugin != removepodsviolatingnodetaints.PluginName || entry.Node != node1.Name {
+			t.Errorf("Unexpected eviction only by the old policy %+v", entry)
+		}
+	}
+
+	expectedClassification := []simulation.NodeClassificationDiff{
+		{Node: node1.Name, Old: []string{}, New: []string{"LowNodeUtilization/overutilized"}},
+		{Node: node2.Name, Old: []string{}, New: []string{"LowNodeUtilization/underutilized"}},
+	}
+	if d := cmp.Diff(expectedClassification, diff.NodeClassification); d != "" {
+		t.Errorf("Unexpected node classification differences (-want +got):\n%s", d)
+	}
+
+	if _, err := DiffPolicies(context.Background(), rs, filepath.Join(dir, "old.yaml"), filepath.Join(dir, "missing.yaml"), snapshotDir); err == nil {
+		t.Errorf("Expected an error comparing with a missing policy")
+	}
+}
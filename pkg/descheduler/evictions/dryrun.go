@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	core "k8s.io/client-go/testing"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+)
+
+var (
+	podsResource = v1.SchemeGroupVersion.WithResource("pods")
+	podsKind     = v1.SchemeGroupVersion.WithKind("Pod")
+	pdbsResource = policy.SchemeGroupVersion.WithResource("poddisruptionbudgets")
+	pdbsKind     = policy.SchemeGroupVersion.WithKind("PodDisruptionBudget")
+)
+
+// DryRunEvictionAPI emulates the eviction API over the objects of a fake client
+// in the dry run mode. The status of the pod disruption budgets is computed from
+// the healthy pods the way the disruption controller does, the evictions which
+// would violate a budget are rejected with the error of the eviction API and the
+// budgets are updated as the evictions go through.
+type DryRunEvictionAPI struct {
+	tracker core.ObjectTracker
+	now     func() time.Time
+}
+
+// NewDryRunEvictionAPI creates an eviction API over the objects of the tracker
+func NewDryRunEvictionAPI(tracker core.ObjectTracker) *DryRunEvictionAPI {
+	return &DryRunEvictionAPI{
+		tracker: tracker,
+		now:     time.Now,
+	}
+}
+
+// SyncBudgets computes the status of all the pod disruption budgets. The budgets
+// copied from a snapshot do not need to carry any status.
+func (a *DryRunEvictionAPI) SyncBudgets() error {
+	list, err := a.tracker.List(pdbsResource, pdbsKind, metav1.NamespaceAll)
+	if err != nil {
+		return fmt.Errorf("unable to list pod disruption budgets: %v", err)
+	}
+	for idx := range list.(*policy.PodDisruptionBudgetList).Items {
+		pdb := list.(*policy.PodDisruptionBudgetList).Items[idx].DeepCopy()
+		if err := a.computeStatus(pdb); err != nil {
+			return err
+		}
+		if err := a.tracker.Update(pdbsResource, pdb, pdb.Namespace); err != nil {
+			return fmt.Errorf("unable to update pod disruption budget %s/%s: %v", pdb.Namespace, pdb.Name, err)
+		}
+	}
+	return nil
+}
+
+// Evict deletes the pod unless the eviction violates its pod disruption budget
+func (a *DryRunEvictionAPI) Evict(eviction *policy.Eviction) error {
+	obj, err := a.tracker.Get(podsResource, eviction.Namespace, eviction.Name)
+	if err != nil {
+		return err
+	}
+	pod := obj.(*v1.Pod)
+	if canIgnorePDB(pod) {
+		return a.deletePod(pod)
+	}
+
+	pdbs, err := a.podPDBs(pod)
+	if err != nil {
+		return err
+	}
+	if len(pdbs) == 0 {
+		return a.deletePod(pod)
+	}
+	if len(pdbs) > 1 {
+		return apierrors.NewInternalError(errors.New("this pod has more than one PodDisruptionBudget, which the eviction subresource does not support"))
+	}
+	pdb := pdbs[0]
+	if err := a.computeStatus(pdb); err != nil {
+		return err
+	}
+
+	// unhealthy pods are evicted without consuming the budget when the policy allows it
+	if !podutil.IsPodReady(pod) {
+		if pdb.Spec.UnhealthyPodEvictionPolicy != nil && *pdb.Spec.UnhealthyPodEvictionPolicy == policy.AlwaysAllow {
+			return a.deletePod(pod)
+		}
+		if pdb.Status.DesiredHealthy > 0 && pdb.Status.CurrentHealthy >= pdb.Status.DesiredHealthy {
+			return a.deletePod(pod)
+		}
+	}
+
+	if pdb.Status.DisruptionsAllowed <= 0 {
+		err := apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		err.ErrStatus.Details.Causes = append(err.ErrStatus.Details.Causes, metav1.StatusCause{
+			Type:    policy.DisruptionBudgetCause,
+			Message: fmt.Sprintf("The disruption budget %s needs %d healthy pods and has %d currently", pdb.Name, pdb.Status.DesiredHealthy, pdb.Status.CurrentHealthy),
+		})
+		return err
+	}
+
+	pdb.Status.DisruptionsAllowed--
+	if pdb.Status.DisruptedPods == nil {
+		pdb.Status.DisruptedPods = map[string]metav1.Time{}
+	}
+	pdb.Status.DisruptedPods[pod.Name] = metav1.NewTime(a.now())
+	if err := a.tracker.Update(pdbsResource, pdb, pdb.Namespace); err != nil {
+		return fmt.Errorf("unable to update pod disruption budget %s/%s: %v", pdb.Namespace, pdb.Name, err)
+	}
+	return a.deletePod(pod)
+}
+
+func (a *DryRunEvictionAPI) deletePod(pod *v1.Pod) error {
+	if err := a.tracker.Delete(podsResource, pod.Namespace, pod.Name); err != nil {
+		return fmt.Errorf("unable to delete pod %v/%v: %v", pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
+
+func (a *DryRunEvictionAPI) podPDBs(pod *v1.Pod) ([]*policy.PodDisruptionBudget, error) {
+	list, err := a.tracker.List(pdbsResource, pdbsKind, pod.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list pod disruption budgets: %v", err)
+	}
+	var pdbs []*policy.PodDisruptionBudget
+	for idx := range list.(*policy.PodDisruptionBudgetList).Items {
+		pdb := &list.(*policy.PodDisruptionBudgetList).Items[idx]
+		if selectsPod(pdb, pod) {
+			pdbs = append(pdbs, pdb.DeepCopy())
+		}
+	}
+	return pdbs, nil
+}
+
+// computeStatus sets the status of the budget from the pods it selects. The expected
+// number of pods observed by the disruption controller is kept, otherwise the pods
+// are counted. Pods recorded as disrupted are not healthy.
+func (a *DryRunEvictionAPI) computeStatus(pdb *policy.PodDisruptionBudget) error {
+	list, err := a.tracker.List(podsResource, podsKind, pdb.Namespace)
+	if err != nil {
+		return fmt.Errorf("unable to list pods: %v", err)
+	}
+	var expected, healthy int32
+	for idx := range list.(*v1.PodList).Items {
+		pod := &list.(*v1.PodList).Items[idx]
+		if !selectsPod(pdb, pod) || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		expected++
+		if _, disrupted := pdb.Status.DisruptedPods[pod.Name]; !disrupted && pod.DeletionTimestamp == nil && podutil.IsPodReady(pod) {
+			healthy++
+		}
+	}
+	if pdb.Status.ObservedGeneration >= pdb.Generation && pdb.Status.ExpectedPods > 0 {
+		expected = pdb.Status.ExpectedPods
+	}
+
+	var desired int32
+	switch {
+	case pdb.Spec.MaxUnavailable != nil:
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, int(expected), true)
+		if err != nil {
+			return fmt.Errorf("invalid maxUnavailable of pod disruption budget %s/%s: %v", pdb.Namespace, pdb.Name, err)
+		}
+		desired = max(expected-int32(maxUnavailable), 0)
+	case pdb.Spec.MinAvailable != nil:
+		minAvailable, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, int(expected), true)
+		if err != nil {
+			return fmt.Errorf("invalid minAvailable of pod disruption budget %s/%s: %v", pdb.Namespace, pdb.Name, err)
+		}
+		desired = int32(minAvailable)
+	}
+
+	pdb.Status.ObservedGeneration = pdb.Generation
+	pdb.Status.ExpectedPods = expected
+	pdb.Status.CurrentHealthy = healthy
+	pdb.Status.DesiredHealthy = desired
+	pdb.Status.DisruptionsAllowed = max(healthy-desired, 0)
+	return nil
+}
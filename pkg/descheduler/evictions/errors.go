@@ -1,10 +1,20 @@
 package evictions
 
+import (
+	"fmt"
+	"time"
+)
+
 type EvictionNodeLimitError struct {
 	node string
+	// profile is set when the limit of a profile is reached
+	profile string
 }
 
 func (e EvictionNodeLimitError) Error() string {
+	if e.profile != "" {
+		return fmt.Sprintf("maximum number of evicted pods per node reached in profile %q", e.profile)
+	}
 	return "maximum number of evicted pods per node reached"
 }
 
@@ -18,9 +28,14 @@ var _ error = &EvictionNodeLimitError{}
 
 type EvictionNamespaceLimitError struct {
 	namespace string
+	// profile is set when the limit of a profile is reached
+	profile string
 }
 
 func (e EvictionNamespaceLimitError) Error() string {
+	if e.profile != "" {
+		return fmt.Sprintf("maximum number of evicted pods per namespace reached in profile %q", e.profile)
+	}
 	return "maximum number of evicted pods per namespace reached"
 }
 
@@ -32,9 +47,15 @@ func NewEvictionNamespaceLimitError(namespace string) *EvictionNamespaceLimitErr
 
 var _ error = &EvictionNamespaceLimitError{}
 
-type EvictionTotalLimitError struct{}
+type EvictionTotalLimitError struct {
+	// profile is set when the limit of a profile is reached
+	profile string
+}
 
 func (e EvictionTotalLimitError) Error() string {
+	if e.profile != "" {
+		return fmt.Sprintf("maximum number of evicted pods per a descheduling cycle reached in profile %q", e.profile)
+	}
 	return "maximum number of evicted pods per a descheduling cycle reached"
 }
 
@@ -43,3 +64,105 @@ func NewEvictionTotalLimitError() *EvictionTotalLimitError {
 }
 
 var _ error = &EvictionTotalLimitError{}
+
+// EvictionRateLimitError is returned when a rate limit spanning descheduling cycles is reached
+type EvictionRateLimitError struct {
+	// namespace is set when the limit counts the evictions in each namespace separately
+	namespace string
+	window    time.Duration
+}
+
+func (e EvictionRateLimitError) Error() string {
+	if e.namespace != "" {
+		return fmt.Sprintf("maximum number of evicted pods per namespace within %v reached", e.window)
+	}
+	return fmt.Sprintf("maximum number of evicted pods within %v reached", e.window)
+}
+
+// PerNamespace reports whether only the evictions in the namespace of the pod are blocked
+// rather than all the evictions
+func (e EvictionRateLimitError) PerNamespace() bool {
+	return e.namespace != ""
+}
+
+// NewEvictionRateLimitError returns the error of a rate limit, the namespace is empty
+// when the limit counts the evictions in all the namespaces
+func NewEvictionRateLimitError(namespace string, window time.Duration) *EvictionRateLimitError {
+	return &EvictionRateLimitError{
+		namespace: namespace,
+		window:    window,
+	}
+}
+
+var _ error = &EvictionRateLimitError{}
+
+type EvictionOwnerLimitError struct {
+	// owner is the namespace/kind/name of the owner
+	owner string
+}
+
+func (e EvictionOwnerLimitError) Error() string {
+	return "maximum number of evicted pods per owner reached"
+}
+
+var _ error = &EvictionOwnerLimitError{}
+
+// EvictionOwnerHeldError is returned in the rolling eviction mode while the
+// replacement of a previously evicted pod of the same owner is not ready yet
+type EvictionOwnerHeldError struct {
+	// owner is the namespace/kind/name of the owner
+	owner string
+}
+
+func (e EvictionOwnerHeldError) Error() string {
+	return "eviction held until the ready replicas of the owner recover"
+}
+
+var _ error = &EvictionOwnerHeldError{}
+
+// EvictionPDBError is returned when the eviction would violate the pod disruption budget of the pod
+type EvictionPDBError struct {
+	// pdb is the namespace/name of the budget
+	pdb    string
+	reason string
+}
+
+func (e EvictionPDBError) Error() string {
+	return e.reason
+}
+
+var _ error = &EvictionPDBError{}
+
+// IsEvictionStopError reports whether no other pod can be evicted due to the
+// total eviction limit or a rate limit not limited to a namespace
+func IsEvictionStopError(err error) bool {
+	switch e := err.(type) {
+	case *EvictionTotalLimitError:
+		return true
+	case *EvictionRateLimitError:
+		return !e.PerNamespace()
+	}
+	return false
+}
+
+// IsEvictionLimitError reports whether the eviction failed due to any of the eviction limits
+func IsEvictionLimitError(err error) bool {
+	switch err.(type) {
+	case *EvictionNodeLimitError, *EvictionNamespaceLimitError, *EvictionTotalLimitError, *EvictionOwnerLimitError, *EvictionRateLimitError:
+		return true
+	}
+	return false
+}
+
+// IsEvictionSkipError reports whether the pod was deliberately not evicted due to any of the eviction limits,
+// the pod disruption budget or the rolling eviction mode rather than the eviction failing
+func IsEvictionSkipError(err error) bool {
+	if IsEvictionLimitError(err) {
+		return true
+	}
+	switch err.(type) {
+	case *EvictionOwnerHeldError, *EvictionPDBError:
+		return true
+	}
+	return false
+}
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	clientset "k8s.io/client-go/kubernetes"
+	policyv1listers "k8s.io/client-go/listers/policy/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/component-base/featuregate"
@@ -38,6 +39,7 @@ import (
 
 	"sigs.k8s.io/descheduler/metrics"
 	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	"sigs.k8s.io/descheduler/pkg/features"
 	"sigs.k8s.io/descheduler/pkg/tracing"
 )
@@ -211,18 +213,32 @@ type PodEvictor struct {
 	policyGroupVersion               string
 	dryRun                           bool
 	evictionFailureEventNotification bool
-	maxPodsToEvictPerNode            *uint
-	maxPodsToEvictPerNamespace       *uint
-	maxPodsToEvictTotal              *uint
 	gracePeriodSeconds               *int64
-	nodePodCount                     nodePodEvictedCount
-	namespacePodCount                namespacePodEvictCount
-	totalPodCount                    uint
+	limiter                          *evictionLimiter
+	profileOptions                   map[string]*ProfileOptions
 	metricsEnabled                   bool
 	eventRecorder                    events.EventRecorder
+	podInformer                      cache.SharedIndexInformer
+	podIndexer                       cache.Indexer
 	erCache                          *evictionRequestsCache
 	featureGates                     featuregate.FeatureGate
 
+	// profileLimiters are created on the first eviction of each profile
+	profileLimiters map[string]*evictionLimiter
+
+	// rateLimiter is nil when no rate limit is configured
+	rateLimiter    *evictionRateLimiter
+	rateLimitStore RateLimitStore
+
+	// ownerLimiter is nil when no per owner limit is configured
+	ownerLimiter *ownerEvictionLimiter
+
+	// rolling is nil unless the rolling eviction mode is enabled
+	rolling *rollingTracker
+
+	// pdbs checks the pod disruption budgets once their lister is set
+	pdbs *pdbTracker
+
 	// registeredHandlers contains the registrations of all handlers. It's used to check if all handlers have finished syncing before the scheduling cycles start.
 	registeredHandlers []cache.ResourceEventHandlerRegistration
 }
@@ -235,24 +251,16 @@ func NewPodEvictor(
 	featureGates featuregate.FeatureGate,
 	options *Options,
 ) (*PodEvictor, error) {
-	if options == nil {
-		options = NewOptions()
-	}
-
 	podEvictor := &PodEvictor{
-		client:                           client,
-		eventRecorder:                    eventRecorder,
-		policyGroupVersion:               options.policyGroupVersion,
-		dryRun:                           options.dryRun,
-		evictionFailureEventNotification: options.evictionFailureEventNotification,
-		maxPodsToEvictPerNode:            options.maxPodsToEvictPerNode,
-		maxPodsToEvictPerNamespace:       options.maxPodsToEvictPerNamespace,
-		maxPodsToEvictTotal:              options.maxPodsToEvictTotal,
-		gracePeriodSeconds:               options.gracePeriodSeconds,
-		metricsEnabled:                   options.metricsEnabled,
-		nodePodCount:                     make(nodePodEvictedCount),
-		namespacePodCount:                make(namespacePodEvictCount),
-		featureGates:                     featureGates,
+		client:        client,
+		eventRecorder: eventRecorder,
+		podInformer:   podInformer,
+		podIndexer:    podInformer.GetIndexer(),
+		featureGates:  featureGates,
+		pdbs:          newPDBTracker(),
+	}
+	if err := podEvictor.configure(options); err != nil {
+		return nil, err
 	}
 
 	if featureGates.Enabled(features.EvictionsInBackground) {
@@ -362,6 +370,65 @@ func NewPodEvictor(
 	return podEvictor, nil
 }
 
+// Reconfigure applies the options of a reloaded policy between descheduling cycles.
+// The state spanning the cycles is kept: the evictions requested in the background,
+// the past evictions counted by the rate limits and the owners held by the rolling evictions.
+func (pe *PodEvictor) Reconfigure(options *Options) error {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	return pe.configure(options)
+}
+
+// configure sets everything given by the options. Must be called with pe.mu held
+// unless the pod evictor is being created.
+func (pe *PodEvictor) configure(options *Options) error {
+	if options == nil {
+		options = NewOptions()
+	}
+
+	var ownerLimiter *ownerEvictionLimiter
+	if options.maxUnavailablePerOwner != nil {
+		indexer, err := podutil.GetPodIndexerByOwnerRefs(podutil.OwnerRefUIDsIndex, pe.podInformer)
+		if err != nil {
+			return fmt.Errorf("unable to index pods by owner for the per owner eviction limit: %v", err)
+		}
+		ownerLimiter = newOwnerEvictionLimiter(*options.maxUnavailablePerOwner, indexer)
+	}
+
+	pe.policyGroupVersion = options.policyGroupVersion
+	pe.dryRun = options.dryRun
+	pe.evictionFailureEventNotification = options.evictionFailureEventNotification
+	pe.gracePeriodSeconds = options.gracePeriodSeconds
+	pe.limiter = newEvictionLimiter("", options.maxPodsToEvictPerNode, options.maxPodsToEvictPerNamespace, options.maxPodsToEvictTotal)
+	pe.profileOptions = options.profiles
+	pe.profileLimiters = make(map[string]*evictionLimiter)
+	pe.metricsEnabled = options.metricsEnabled
+	pe.ownerLimiter = ownerLimiter
+
+	if len(options.rateLimits) > 0 {
+		rateLimiter := newEvictionRateLimiter(options.rateLimits)
+		if pe.rateLimiter != nil {
+			rateLimiter.evictions = pe.rateLimiter.evictions
+			rateLimiter.changed = pe.rateLimiter.changed
+		}
+		pe.rateLimiter = rateLimiter
+		pe.rateLimitStore = options.rateLimitStore
+	} else {
+		pe.rateLimiter = nil
+		pe.rateLimitStore = nil
+	}
+
+	switch {
+	case options.rollingEvictionTimeout == nil:
+		pe.rolling = nil
+	case pe.rolling != nil:
+		pe.rolling.timeout = *options.rollingEvictionTimeout
+	default:
+		pe.rolling = newRollingTracker(*options.rollingEvictionTimeout, pe.podIndexer)
+	}
+	return nil
+}
+
 // WaitForEventHandlersSync waits for EventHandlers to sync.
 // It returns true if it was successful, false if the controller should shut down
 func (pe *PodEvictor) WaitForEventHandlersSync(ctx context.Context) error {
@@ -379,22 +446,86 @@ func (pe *PodEvictor) WaitForEventHandlersSync(ctx context.Context) error {
 func (pe *PodEvictor) NodeEvicted(node *v1.Node) uint {
 	pe.mu.RLock()
 	defer pe.mu.RUnlock()
-	return pe.nodePodCount[node.Name]
+	return pe.limiter.nodePodCount[node.Name]
 }
 
 // TotalEvicted gives a number of pods evicted through all nodes
 func (pe *PodEvictor) TotalEvicted() uint {
 	pe.mu.RLock()
 	defer pe.mu.RUnlock()
-	return pe.totalPodCount
+	return pe.limiter.totalPodCount
+}
+
+// ProfileEvicted gives a number of pods evicted by the profile through all nodes
+func (pe *PodEvictor) ProfileEvicted(profileName string) uint {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	if limiter, ok := pe.profileLimiters[profileName]; ok {
+		return limiter.totalPodCount
+	}
+	return 0
 }
 
 func (pe *PodEvictor) ResetCounters() {
 	pe.mu.Lock()
 	defer pe.mu.Unlock()
-	pe.nodePodCount = make(nodePodEvictedCount)
-	pe.namespacePodCount = make(namespacePodEvictCount)
-	pe.totalPodCount = 0
+	pe.limiter.reset()
+	for _, limiter := range pe.profileLimiters {
+		limiter.reset()
+	}
+	if pe.ownerLimiter != nil {
+		pe.ownerLimiter.reset()
+	}
+	pe.pdbs.reset()
+}
+
+// LoadRateLimitState restores the rate limit state from the store. The state
+// in memory is kept when it holds evictions not saved yet.
+func (pe *PodEvictor) LoadRateLimitState(ctx context.Context) error {
+	if pe.rateLimiter == nil || pe.rateLimitStore == nil {
+		return nil
+	}
+	state, err := pe.rateLimitStore.Load(ctx)
+	if err != nil {
+		return err
+	}
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	if pe.rateLimiter.changed {
+		return nil
+	}
+	pe.rateLimiter.restore(state)
+	return nil
+}
+
+// SaveRateLimitState persists the rate limit state when any eviction was recorded since the last save
+func (pe *PodEvictor) SaveRateLimitState(ctx context.Context) error {
+	if pe.rateLimiter == nil || pe.rateLimitStore == nil {
+		return nil
+	}
+	pe.mu.Lock()
+	if !pe.rateLimiter.changed {
+		pe.mu.Unlock()
+		return nil
+	}
+	state, since := pe.rateLimiter.state(), pe.rateLimiter.since()
+	pe.rateLimiter.changed = false
+	pe.mu.Unlock()
+
+	if err := pe.rateLimitStore.Save(ctx, state, since); err != nil {
+		pe.mu.Lock()
+		pe.rateLimiter.changed = true
+		pe.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// DryRun reports whether the evictions are only simulated
+func (pe *PodEvictor) DryRun() bool {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return pe.dryRun
 }
 
 func (pe *PodEvictor) SetClient(client clientset.Interface) {
@@ -403,6 +534,26 @@ func (pe *PodEvictor) SetClient(client clientset.Interface) {
 	pe.client = client
 }
 
+// SetPodDisruptionBudgetLister enables the checks of the pod disruption budgets
+// before the eviction API is called
+func (pe *PodEvictor) SetPodDisruptionBudgetLister(lister policyv1listers.PodDisruptionBudgetLister) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.pdbs.lister = lister
+}
+
+// SetPodIndexer sets the pods the ready replicas of the owners are counted from
+// in the rolling eviction mode. In the dry run mode these are the pods of the
+// copy of the cluster the evictions are simulated on.
+func (pe *PodEvictor) SetPodIndexer(indexer cache.Indexer) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.podIndexer = indexer
+	if pe.rolling != nil {
+		pe.rolling.setPods(indexer)
+	}
+}
+
 func (pe *PodEvictor) evictionRequestsTotal() uint {
 	if pe.featureGates.Enabled(features.EvictionsInBackground) {
 		return pe.erCache.evictionRequestsTotal()
@@ -451,6 +602,12 @@ type EvictOptions struct {
 	ProfileName string
 	// StrategyName allows for passing details about strategy for observability.
 	StrategyName string
+	// Score is the benefit of the eviction as estimated by the strategy,
+	// usually how far the pod or its node is past the strategy threshold
+	// (1 when right at it). In the plan-then-execute mode candidates with a
+	// higher score are evicted first, strategies that do not estimate the
+	// benefit leave it at 0.
+	Score float64
 }
 
 // EvictPod evicts a pod while exercising eviction limits.
@@ -471,55 +628,50 @@ func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptio
 		}
 	}
 
-	pe.mu.Lock()
-	defer pe.mu.Unlock()
-
 	var span trace.Span
 	ctx, span = tracing.Tracer().Start(ctx, "EvictPod", trace.WithAttributes(attribute.String("podName", pod.Name), attribute.String("podNamespace", pod.Namespace), attribute.String("reason", opts.Reason), attribute.String("operation", tracing.EvictOperation)))
 	defer span.End()
 
-	if pe.maxPodsToEvictTotal != nil && pe.totalPodCount+pe.evictionRequestsTotal()+1 > *pe.maxPodsToEvictTotal {
-		err := NewEvictionTotalLimitError()
-		if pe.metricsEnabled {
-			metrics.PodsEvicted.With(map[string]string{"result": err.Error(), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
-		}
-		span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
-		klog.ErrorS(err, "Error evicting pod", "limit", *pe.maxPodsToEvictTotal)
-		if pe.evictionFailureEventNotification {
-			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: total eviction limit exceeded (%v)", pod.Spec.NodeName, *pe.maxPodsToEvictTotal)
-		}
+	// Limits are checked and a slot reserved atomically so concurrent evictions
+	// never exceed them. The lock is not held during the eviction API call.
+	pe.mu.Lock()
+	limiters, gracePeriodSeconds := pe.profileEvictionSettings(opts.ProfileName)
+	if limit, err := pe.reserve(pod, limiters); err != nil {
+		pe.mu.Unlock()
+		pe.recordLimitError(span, pod, opts, err, limit)
 		return err
 	}
+	client := pe.client
+	pe.mu.Unlock()
 
-	if pod.Spec.NodeName != "" {
-		if pe.maxPodsToEvictPerNode != nil && pe.nodePodCount[pod.Spec.NodeName]+pe.evictionRequestsPerNode(pod.Spec.NodeName)+1 > *pe.maxPodsToEvictPerNode {
-			err := NewEvictionNodeLimitError(pod.Spec.NodeName)
-			if pe.metricsEnabled {
-				metrics.PodsEvicted.With(map[string]string{"result": err.Error(), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
-			}
-			span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
-			klog.ErrorS(err, "Error evicting pod", "limit", *pe.maxPodsToEvictPerNode, "node", pod.Spec.NodeName)
-			if pe.evictionFailureEventNotification {
-				pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: node eviction limit exceeded (%v)", pod.Spec.NodeName, *pe.maxPodsToEvictPerNode)
-			}
-			return err
+	ignore, err := pe.evictPod(ctx, client, pod, gracePeriodSeconds)
+
+	pe.mu.Lock()
+	for _, limiter := range limiters {
+		limiter.release(pod)
+		if err == nil && !ignore {
+			limiter.count(pod)
 		}
 	}
-
-	if pe.maxPodsToEvictPerNamespace != nil && pe.namespacePodCount[pod.Namespace]+pe.evictionRequestsPerNamespace(pod.Namespace)+1 > *pe.maxPodsToEvictPerNamespace {
-		err := NewEvictionNamespaceLimitError(pod.Namespace)
-		if pe.metricsEnabled {
-			metrics.PodsEvicted.With(map[string]string{"result": err.Error(), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+	if pe.ownerLimiter != nil {
+		pe.ownerLimiter.release(pod)
+		if err == nil && !ignore {
+			pe.ownerLimiter.count(pod)
 		}
-		span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
-		klog.ErrorS(err, "Error evicting pod", "limit", *pe.maxPodsToEvictPerNamespace, "namespace", pod.Namespace, "pod", klog.KObj(pod))
-		if pe.evictionFailureEventNotification {
-			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: namespace eviction limit exceeded (%v)", pod.Spec.NodeName, *pe.maxPodsToEvictPerNamespace)
+	}
+	if pe.rateLimiter != nil {
+		pe.rateLimiter.release(pod)
+		// evictions requested in the background count against the rate limits as well
+		if err == nil {
+			pe.rateLimiter.count(pod)
 		}
-		return err
 	}
+	if pe.rolling != nil {
+		pe.rolling.release(pod, err == nil)
+	}
+	pe.pdbs.release(pod, err == nil)
+	pe.mu.Unlock()
 
-	ignore, err := pe.evictPod(ctx, pod)
 	if err != nil {
 		// err is used only for logging purposes
 		span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
@@ -537,12 +689,6 @@ func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptio
 		return nil
 	}
 
-	if pod.Spec.NodeName != "" {
-		pe.nodePodCount[pod.Spec.NodeName]++
-	}
-	pe.namespacePodCount[pod.Namespace]++
-	pe.totalPodCount++
-
 	if pe.metricsEnabled {
 		metrics.PodsEvicted.With(map[string]string{"result": "success", "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
 	}
@@ -563,10 +709,161 @@ func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptio
 	return nil
 }
 
+// profileEvictionSettings returns the limiters an eviction of the profile is subject to
+// and the grace period to use. Must be called with pe.mu held.
+func (pe *PodEvictor) profileEvictionSettings(profileName string) ([]*evictionLimiter, *int64) {
+	if profileName == "" {
+		return []*evictionLimiter{pe.limiter}, pe.gracePeriodSeconds
+	}
+	profileOptions := pe.profileOptions[profileName]
+	if profileOptions == nil {
+		profileOptions = NewProfileOptions()
+	}
+	limiter, ok := pe.profileLimiters[profileName]
+	if !ok {
+		limiter = newEvictionLimiter(profileName, profileOptions.maxPodsToEvictPerNode, profileOptions.maxPodsToEvictPerNamespace, profileOptions.maxPodsToEvictTotal)
+		pe.profileLimiters[profileName] = limiter
+	}
+	gracePeriodSeconds := pe.gracePeriodSeconds
+	if profileOptions.gracePeriodSeconds != nil {
+		gracePeriodSeconds = profileOptions.gracePeriodSeconds
+	}
+	return []*evictionLimiter{pe.limiter, limiter}, gracePeriodSeconds
+}
+
+// reserve checks all the limits, including the per owner and rate limits, the
+// rolling eviction mode and the pod disruption budgets, and reserves a slot for the pod in each of them.
+// Evictions in progress and, for the global limits, evictions requested in the
+// background count against the limits. The value of the limit that would be
+// exceeded is returned together with the error.
+// Must be called with pe.mu held.
+func (pe *PodEvictor) reserve(pod *v1.Pod, limiters []*evictionLimiter) (uint, error) {
+	for _, limiter := range limiters {
+		var err error
+		if limiter == pe.limiter {
+			err = limiter.check(pod, pe.evictionRequestsTotal(), pe.evictionRequestsPerNode(pod.Spec.NodeName), pe.evictionRequestsPerNamespace(pod.Namespace))
+		} else {
+			err = limiter.check(pod, 0, 0, 0)
+		}
+		if err != nil {
+			return limiter.limit(err), err
+		}
+	}
+	if pe.ownerLimiter != nil {
+		if limit, err := pe.ownerLimiter.check(pod); err != nil {
+			return limit, err
+		}
+	}
+	if pe.rateLimiter != nil {
+		if limit, err := pe.rateLimiter.check(pod); err != nil {
+			return limit, err
+		}
+	}
+	if pe.rolling != nil {
+		if err := pe.rolling.check(pod); err != nil {
+			return 0, err
+		}
+	}
+	pdb, err := pe.pdbs.check(pod)
+	if err != nil {
+		return 0, err
+	}
+	if pe.rolling != nil {
+		pe.rolling.reserve(pod)
+	}
+	pe.pdbs.reserve(pod, pdb)
+	if pe.ownerLimiter != nil {
+		pe.ownerLimiter.reserve(pod)
+	}
+	if pe.rateLimiter != nil {
+		pe.rateLimiter.reserve(pod)
+	}
+	for _, limiter := range limiters {
+		limiter.reserve(pod)
+	}
+	return 0, nil
+}
+
+func (pe *PodEvictor) recordLimitError(span trace.Span, pod *v1.Pod, opts EvictOptions, err error, limit uint) {
+	if pe.metricsEnabled {
+		metrics.PodsEvicted.With(map[string]string{"result": skipResult(err), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+	}
+	span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
+
+	if heldErr, ok := err.(*EvictionOwnerHeldError); ok {
+		klog.V(2).InfoS("Eviction held", "pod", klog.KObj(pod), "owner", heldErr.owner, "profile", opts.ProfileName)
+		if pe.evictionFailureEventNotification {
+			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler held until the ready replicas of %v recover", pod.Spec.NodeName, heldErr.owner)
+		}
+		return
+	}
+	if pdbErr, ok := err.(*EvictionPDBError); ok {
+		klog.V(2).InfoS("Eviction skipped", "pod", klog.KObj(pod), "reason", pdbErr.reason, "profile", opts.ProfileName)
+		if pe.evictionFailureEventNotification {
+			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler skipped: %v", pod.Spec.NodeName, pdbErr.reason)
+		}
+		return
+	}
+
+	var limitName string
+	switch limitErr := err.(type) {
+	case *EvictionTotalLimitError:
+		limitName = "total"
+		klog.ErrorS(err, "Error evicting pod", "limit", limit, "profile", opts.ProfileName)
+	case *EvictionNodeLimitError:
+		limitName = "node"
+		klog.ErrorS(err, "Error evicting pod", "limit", limit, "node", pod.Spec.NodeName, "profile", opts.ProfileName)
+	case *EvictionNamespaceLimitError:
+		limitName = "namespace"
+		klog.ErrorS(err, "Error evicting pod", "limit", limit, "namespace", pod.Namespace, "pod", klog.KObj(pod), "profile", opts.ProfileName)
+	case *EvictionOwnerLimitError:
+		limitName = "owner"
+		klog.V(2).InfoS("Eviction skipped", "pod", klog.KObj(pod), "reason", err.Error(), "limit", limit, "owner", limitErr.owner, "profile", opts.ProfileName)
+	case *EvictionRateLimitError:
+		limitName = "rate"
+		klog.V(2).InfoS("Eviction skipped", "pod", klog.KObj(pod), "reason", err.Error(), "limit", limit, "window", limitErr.window, "profile", opts.ProfileName)
+	}
+	if pe.evictionFailureEventNotification {
+		pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: %v eviction limit exceeded (%v)", pod.Spec.NodeName, limitName, limit)
+	}
+}
+
+// skipResult returns the result the pods not evicted due to the error are counted with in the
+// pods_evicted metric. The node, namespace and total limits keep their results regardless of the
+// profile the limit is set for, the profile is a label of its own.
+func skipResult(err error) string {
+	switch err.(type) {
+	case *EvictionTotalLimitError:
+		return EvictionTotalLimitError{}.Error()
+	case *EvictionNodeLimitError:
+		return EvictionNodeLimitError{}.Error()
+	case *EvictionNamespaceLimitError:
+		return EvictionNamespaceLimitError{}.Error()
+	case *EvictionRateLimitError:
+		return "maximum number of evicted pods per rate limit window reached"
+	case *EvictionPDBError:
+		// the reason names the budget
+		return "pod disruption budget does not allow the eviction"
+	}
+	return err.Error()
+}
+
+// LogEvictionError logs the error returned for the eviction of the pod. Pods skipped due to the
+// per owner and rate limits, the rolling eviction mode or the pod disruption budgets are logged
+// at V(2), any other error is logged as an error.
+func LogEvictionError(pod *v1.Pod, err error) {
+	switch err.(type) {
+	case *EvictionOwnerLimitError, *EvictionRateLimitError, *EvictionOwnerHeldError, *EvictionPDBError:
+		klog.V(2).InfoS("Pod eviction skipped", "pod", klog.KObj(pod), "reason", err.Error())
+		return
+	}
+	klog.Errorf("eviction failed: %v", err)
+}
+
 // return (ignore, err)
-func (pe *PodEvictor) evictPod(ctx context.Context, pod *v1.Pod) (bool, error) {
+func (pe *PodEvictor) evictPod(ctx context.Context, client clientset.Interface, pod *v1.Pod, gracePeriodSeconds *int64) (bool, error) {
 	deleteOptions := &metav1.DeleteOptions{
-		GracePeriodSeconds: pe.gracePeriodSeconds,
+		GracePeriodSeconds: gracePeriodSeconds,
 	}
 	// GracePeriodSeconds ?
 	eviction := &policy.Eviction{
@@ -580,7 +877,7 @@ func (pe *PodEvictor) evictPod(ctx context.Context, pod *v1.Pod) (bool, error) {
 		},
 		DeleteOptions: deleteOptions,
 	}
-	err := pe.client.PolicyV1().Evictions(eviction.Namespace).Evict(ctx, eviction)
+	err := client.PolicyV1().Evictions(eviction.Namespace).Evict(ctx, eviction)
 	if err == nil {
 		return false, nil
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +30,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
@@ -114,7 +117,7 @@ func TestEvictPod(t *testing.T) {
 				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
 			}
 
-			_, got := podEvictor.evictPod(ctx, test.evictedPod)
+			_, got := podEvictor.evictPod(ctx, podEvictor.client, test.evictedPod, nil)
 			if got != test.wantErr {
 				t.Errorf("Test error for Desc: %s. Expected %v pod eviction to be %v, got %v", test.description, test.evictedPod.Name, test.wantErr, got)
 			}
@@ -459,6 +462,202 @@ func TestEvictionRequestsCacheCleanup(t *testing.T) {
 	}
 }
 
+func TestEvictPodReservesLimits(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	p1 := test.BuildTestPod("p1", 100, 0, node1.Name, nil)
+	p2 := test.BuildTestPod("p2", 100, 0, node1.Name, nil)
+
+	client := fakeclientset.NewSimpleClientset(node1, p1, p2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithMaxPodsToEvictTotal(utilptr.To[uint](1)),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	var inFlightErr error
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			// The pod evictor is not locked during the eviction API call.
+			// The slot reserved for p1 is expected to prevent p2 from being evicted.
+			inFlightErr = podEvictor.EvictPod(ctx, p2, EvictOptions{})
+			return true, nil, nil
+		}
+		return false, nil, nil
+	})
+
+	if err := podEvictor.EvictPod(ctx, p1, EvictOptions{}); err != nil {
+		t.Fatalf("Unexpected error when evicting p1: %v", err)
+	}
+	if _, ok := inFlightErr.(*EvictionTotalLimitError); !ok {
+		t.Fatalf("Expected total limit error when evicting p2 during an eviction in progress, got %v instead", inFlightErr)
+	}
+	if total := podEvictor.TotalEvicted(); total != 1 {
+		t.Fatalf("Expected %v total evictions, got %v instead", 1, total)
+	}
+	if podEvictor.limiter.totalPodInFlight != 0 || len(podEvictor.limiter.nodePodInFlight) != 0 || len(podEvictor.limiter.namespacePodInFlight) != 0 {
+		t.Fatalf("Expected all the reserved slots to be released")
+	}
+}
+
+func TestEvictPodConcurrently(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{
+		test.BuildTestNode("n1", 2000, 3000, 10, nil),
+		test.BuildTestNode("n2", 2000, 3000, 10, nil),
+		test.BuildTestNode("n3", 2000, 3000, 10, nil),
+	}
+	objects := []runtime.Object{}
+	pods := []*v1.Pod{}
+	for _, node := range nodes {
+		objects = append(objects, node)
+		for i := 0; i < 4; i++ {
+			pod := test.BuildTestPod(fmt.Sprintf("%v-p%v", node.Name, i), 100, 0, node.Name, nil)
+			objects = append(objects, pod)
+			pods = append(pods, pod)
+		}
+	}
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().
+			WithMaxPodsToEvictPerNode(utilptr.To[uint](2)).
+			WithMaxPodsToEvictTotal(utilptr.To[uint](5)),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		wg.Add(1)
+		go func(pod *v1.Pod) {
+			defer wg.Done()
+			podEvictor.EvictPod(ctx, pod, EvictOptions{})
+		}(pod)
+	}
+	wg.Wait()
+
+	if total := podEvictor.TotalEvicted(); total != 5 {
+		t.Fatalf("Expected %v total evictions, got %v instead", 5, total)
+	}
+	for _, node := range nodes {
+		if evicted := podEvictor.NodeEvicted(node); evicted > 2 {
+			t.Fatalf("Expected at most %v evictions on node %v, got %v instead", 2, node.Name, evicted)
+		}
+	}
+}
+
+func TestProfileEvictionLimits(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	objects := []runtime.Object{node1, node2}
+	pods := map[string]*v1.Pod{}
+	for _, node := range []*v1.Node{node1, node2} {
+		for i := 0; i < 3; i++ {
+			pod := test.BuildTestPod(fmt.Sprintf("%v-p%v", node.Name, i), 100, 0, node.Name, nil)
+			objects = append(objects, pod)
+			pods[pod.Name] = pod
+		}
+	}
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().
+			WithMaxPodsToEvictTotal(utilptr.To[uint](4)).
+			WithGracePeriodSeconds(utilptr.To[int64](30)).
+			WithProfileOptions("conservative", NewProfileOptions().
+				WithMaxPodsToEvictPerNode(utilptr.To[uint](1)).
+				WithGracePeriodSeconds(utilptr.To[int64](300)),
+			),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	gracePeriods := map[string]int64{}
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			eviction := action.(core.CreateActionImpl).Object.(*policy.Eviction)
+			gracePeriods[eviction.Name] = *eviction.DeleteOptions.GracePeriodSeconds
+			return true, nil, nil
+		}
+		return false, nil, nil
+	})
+
+	tests := []struct {
+		pod                 string
+		profile             string
+		expectedErr         string
+		expectedGracePeriod int64
+	}{
+		{pod: "n1-p0", profile: "conservative", expectedGracePeriod: 300},
+		{pod: "n1-p1", profile: "conservative", expectedErr: "maximum number of evicted pods per node reached in profile \"conservative\""},
+		{pod: "n2-p0", profile: "conservative", expectedGracePeriod: 300},
+		// the node limit of the conservative profile does not apply to other profiles
+		{pod: "n1-p1", profile: "aggressive", expectedGracePeriod: 30},
+		{pod: "n1-p2", profile: "aggressive", expectedGracePeriod: 30},
+		// the global limit applies to all the profiles
+		{pod: "n2-p1", profile: "aggressive", expectedErr: "maximum number of evicted pods per a descheduling cycle reached"},
+	}
+	for _, tc := range tests {
+		err := podEvictor.EvictPod(ctx, pods[tc.pod], EvictOptions{ProfileName: tc.profile})
+		if tc.expectedErr != "" {
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("Expected %q error when evicting %v by %v profile, got %v instead", tc.expectedErr, tc.pod, tc.profile, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error when evicting %v by %v profile: %v", tc.pod, tc.profile, err)
+			continue
+		}
+		if gracePeriods[tc.pod] != tc.expectedGracePeriod {
+			t.Errorf("Expected %v evicted with %v grace period, got %v instead", tc.pod, tc.expectedGracePeriod, gracePeriods[tc.pod])
+		}
+	}
+
+	if total := podEvictor.TotalEvicted(); total != 4 {
+		t.Errorf("Expected %v total evictions, got %v instead", 4, total)
+	}
+	for profile, expected := range map[string]uint{"conservative": 2, "aggressive": 2} {
+		if evicted := podEvictor.ProfileEvicted(profile); evicted != expected {
+			t.Errorf("Expected %v evictions by %v profile, got %v instead", expected, profile, evicted)
+		}
+	}
+
+	podEvictor.ResetCounters()
+	if evicted := podEvictor.ProfileEvicted("conservative"); evicted != 0 {
+		t.Errorf("Expected the profile counters to be reset, got %v evictions", evicted)
+	}
+}
+
 func assertEqualEvents(t *testing.T, expected []string, actual <-chan string) {
 	t.Logf("Assert for events: %v", expected)
 	c := time.After(wait.ForeverTestTimeout)
@@ -482,3 +681,768 @@ func assertEqualEvents(t *testing.T, expected []string, actual <-chan string) {
 		}
 	}
 }
+
+func TestEvictionRateLimits(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	objects := []runtime.Object{node1}
+	pods := map[string]*v1.Pod{}
+	for _, namespace := range []string{"ns1", "ns2"} {
+		for i := 0; i < 4; i++ {
+			pod := test.BuildTestPod(fmt.Sprintf("%v-p%v", namespace, i), 100, 0, node1.Name, func(pod *v1.Pod) {
+				pod.Namespace = namespace
+			})
+			objects = append(objects, pod)
+			pods[pod.Name] = pod
+		}
+	}
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	newPodEvictor := func() *PodEvictor {
+		podEvictor, err := NewPodEvictor(
+			ctx,
+			client,
+			eventRecorder,
+			sharedInformerFactory.Core().V1().Pods().Informer(),
+			initFeatureGates(),
+			NewOptions().
+				WithRateLimits([]RateLimit{
+					{MaxEvictions: 3, Window: time.Hour},
+					{PerNamespace: true, MaxEvictions: 2, Window: 24 * time.Hour},
+				}).
+				WithRateLimitStore(NewConfigMapRateLimitStore(client, "kube-system", "descheduler-eviction-rate-limits")),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+		}
+		return podEvictor
+	}
+
+	now := time.Date(2026, 3, 6, 10, 0, 0, 0, time.UTC)
+	podEvictor := newPodEvictor()
+	podEvictor.rateLimiter.now = func() time.Time { return now }
+
+	evict := func(podEvictor *PodEvictor, pod, expectedErr string) {
+		t.Helper()
+		// the rate limits span descheduling cycles
+		podEvictor.ResetCounters()
+		err := podEvictor.EvictPod(ctx, pods[pod], EvictOptions{})
+		if expectedErr == "" && err != nil {
+			t.Errorf("Unexpected error when evicting %v: %v", pod, err)
+		}
+		if expectedErr != "" && (err == nil || err.Error() != expectedErr) {
+			t.Errorf("Expected %q error when evicting %v, got %v instead", expectedErr, pod, err)
+		}
+	}
+
+	evict(podEvictor, "ns1-p0", "")
+	evict(podEvictor, "ns1-p1", "")
+	evict(podEvictor, "ns1-p2", "maximum number of evicted pods per namespace within 24h0m0s reached")
+	evict(podEvictor, "ns2-p0", "")
+	evict(podEvictor, "ns2-p1", "maximum number of evicted pods within 1h0m0s reached")
+
+	if err := podEvictor.SaveRateLimitState(ctx); err != nil {
+		t.Fatalf("Unable to save the rate limit state: %v", err)
+	}
+
+	// a restarted descheduler continues with the persisted state
+	now = now.Add(time.Hour)
+	restarted := newPodEvictor()
+	restarted.rateLimiter.now = func() time.Time { return now }
+	if err := restarted.LoadRateLimitState(ctx); err != nil {
+		t.Fatalf("Unable to load the rate limit state: %v", err)
+	}
+	evict(restarted, "ns1-p3", "maximum number of evicted pods per namespace within 24h0m0s reached")
+	evict(restarted, "ns2-p1", "")
+	evict(restarted, "ns2-p2", "maximum number of evicted pods per namespace within 24h0m0s reached")
+
+	now = now.Add(24 * time.Hour)
+	evict(restarted, "ns1-p3", "")
+}
+
+func TestConfigMapRateLimitStoreSave(t *testing.T) {
+	ctx := context.Background()
+	client := fakeclientset.NewSimpleClientset()
+	store := NewConfigMapRateLimitStore(client, "kube-system", "descheduler-eviction-rate-limits")
+
+	t1 := time.Date(2026, 3, 6, 10, 0, 0, 0, time.UTC)
+	t2, t3 := t1.Add(time.Minute), t1.Add(2*time.Minute)
+	// the evictions before since are outside of all the windows
+	since := t1.Add(-time.Hour)
+	expired := since.Add(-time.Minute)
+
+	// two instances of the descheduler save their own evictions
+	if err := store.Save(ctx, RateLimitState{"ns1": {t1}, "ns3": {expired}}, expired.Add(-time.Hour)); err != nil {
+		t.Fatalf("Unable to save the rate limit state: %v", err)
+	}
+	conflicts := 0
+	client.PrependReactor("update", "configmaps", func(action core.Action) (bool, runtime.Object, error) {
+		if conflicts > 0 {
+			return false, nil, nil
+		}
+		conflicts++
+		return true, nil, apierrors.NewConflict(v1.Resource("configmaps"), "descheduler-eviction-rate-limits", fmt.Errorf("the object has been modified"))
+	})
+	// two pods may be evicted at the same time
+	if err := store.Save(ctx, RateLimitState{"ns2": {t2, t2}}, since); err != nil {
+		t.Fatalf("Unable to save the rate limit state: %v", err)
+	}
+	if conflicts != 1 {
+		t.Errorf("Expected the update to conflict once, got %v conflicts", conflicts)
+	}
+	// the evictions loaded from the store are saved again
+	if err := store.Save(ctx, RateLimitState{"ns1": {t1, t3}}, since); err != nil {
+		t.Fatalf("Unable to save the rate limit state: %v", err)
+	}
+
+	state, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("Unable to load the rate limit state: %v", err)
+	}
+	// the expired eviction stored by the other instance is dropped
+	expected := RateLimitState{"ns1": {t1, t3}, "ns2": {t2, t2}}
+	if !reflect.DeepEqual(expected, state) {
+		t.Errorf("Expected %v rate limit state, got %v", expected, state)
+	}
+}
+
+func TestPodEvictorReconfigure(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	objects := []runtime.Object{node1}
+	pods := map[string]*v1.Pod{}
+	for i := 0; i < 4; i++ {
+		pod := test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, nil)
+		objects = append(objects, pod)
+		pods[pod.Name] = pod
+	}
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().
+			WithMaxPodsToEvictTotal(utilptr.To[uint](1)).
+			WithRateLimits([]RateLimit{{MaxEvictions: 2, Window: time.Hour}}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	evict := func(pod, expectedErr string) {
+		t.Helper()
+		err := podEvictor.EvictPod(ctx, pods[pod], EvictOptions{})
+		if expectedErr == "" && err != nil {
+			t.Errorf("Unexpected error when evicting %v: %v", pod, err)
+		}
+		if expectedErr != "" && (err == nil || err.Error() != expectedErr) {
+			t.Errorf("Expected %q error when evicting %v, got %v instead", expectedErr, pod, err)
+		}
+	}
+	reconfigure := func(options *Options) {
+		t.Helper()
+		if err := podEvictor.Reconfigure(options); err != nil {
+			t.Fatalf("Unexpected error when reconfiguring the pod evictor: %v", err)
+		}
+	}
+
+	evict("p0", "")
+	evict("p1", "maximum number of evicted pods per a descheduling cycle reached")
+
+	// the limits change, the past evictions still count against the rate limits
+	reconfigure(NewOptions().
+		WithMaxPodsToEvictTotal(utilptr.To[uint](3)).
+		WithRateLimits([]RateLimit{{MaxEvictions: 2, Window: time.Hour}}))
+	evict("p1", "")
+	evict("p2", "maximum number of evicted pods within 1h0m0s reached")
+
+	reconfigure(NewOptions().
+		WithMaxPodsToEvictTotal(utilptr.To[uint](3)).
+		WithRateLimits([]RateLimit{{MaxEvictions: 3, Window: time.Hour}}))
+	evict("p2", "")
+
+	// the rate limits are dropped with the policy
+	reconfigure(NewOptions())
+	if podEvictor.rateLimiter != nil {
+		t.Errorf("Expected no rate limiter, got %v", podEvictor.rateLimiter)
+	}
+	evict("p3", "")
+}
+
+func TestRollingEvictions(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	readyPod := func(name string, ownerRefs []metav1.OwnerReference) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = ownerRefs
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+		})
+	}
+	p1 := readyPod("p1", test.GetReplicaSetOwnerRefList())
+	p2 := readyPod("p2", test.GetReplicaSetOwnerRefList())
+	p3 := readyPod("p3", test.GetReplicaSetOwnerRefList())
+	s1 := readyPod("s1", test.GetStatefulSetOwnerRefList())
+	s2 := readyPod("s2", test.GetStatefulSetOwnerRefList())
+	n1 := readyPod("n1", test.GetNormalPodOwnerRefList())
+	n2 := readyPod("n2", test.GetNormalPodOwnerRefList())
+
+	client := fakeclientset.NewSimpleClientset(node1, p1, p2, p3, s1, s2, n1, n2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	for _, pod := range []*v1.Pod{p1, p2, p3, s1, s2, n1, n2} {
+		podInformer.GetIndexer().Add(pod)
+	}
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithRollingEvictions(10*time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	now := time.Date(2026, 3, 6, 10, 0, 0, 0, time.UTC)
+	podEvictor.rolling.now = func() time.Time { return now }
+
+	evict := func(pod *v1.Pod, held bool) {
+		t.Helper()
+		err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+		if _, ok := err.(*EvictionOwnerHeldError); ok != held {
+			t.Errorf("Expected the eviction of %v to be held: %v, got %v", pod.Name, held, err)
+		}
+	}
+
+	evict(p1, false)
+	evict(p2, true)
+	// pods of other owners and pods with no ReplicaSet or StatefulSet owner are not held
+	evict(s1, false)
+	evict(n1, false)
+	evict(n2, false)
+	evict(s2, true)
+
+	// the evicted pod is terminating and its replacement is not ready yet
+	terminating := p1.DeepCopy()
+	terminating.DeletionTimestamp = &metav1.Time{Time: now}
+	podInformer.GetIndexer().Update(terminating)
+	podInformer.GetIndexer().Add(test.BuildTestPod("p4", 100, 0, node1.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+	}))
+	evict(p2, true)
+
+	// the replacement got ready
+	podInformer.GetIndexer().Delete(terminating)
+	podInformer.GetIndexer().Update(readyPod("p4", test.GetReplicaSetOwnerRefList()))
+	evict(p2, false)
+	evict(p3, true)
+
+	// the ready replicas did not recover in time
+	now = now.Add(10 * time.Minute)
+	evict(p3, false)
+	evict(s2, false)
+}
+
+func TestOwnerEvictionLimits(t *testing.T) {
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	ownedPods := func(owner string, replicas int) []*v1.Pod {
+		ownerRef := metav1.OwnerReference{Kind: "ReplicaSet", APIVersion: "v1", Name: owner, UID: types.UID(owner)}
+		var pods []*v1.Pod
+		for i := 0; i < replicas; i++ {
+			pods = append(pods, test.BuildTestPod(fmt.Sprintf("%v-%v", owner, i), 100, 0, node1.Name, func(pod *v1.Pod) {
+				pod.ObjectMeta.OwnerReferences = []metav1.OwnerReference{ownerRef}
+			}))
+		}
+		return pods
+	}
+
+	tests := []struct {
+		description    string
+		maxUnavailable intstr.IntOrString
+		// expected evictions per owner, each owner is asked to evict all its pods
+		expected map[string]int
+	}{
+		{
+			description:    "absolute number",
+			maxUnavailable: intstr.FromInt32(2),
+			expected:       map[string]int{"rs1": 2, "rs2": 2, "rs3": 1},
+		},
+		{
+			description:    "percentage rounded up",
+			maxUnavailable: intstr.FromString("10%"),
+			expected:       map[string]int{"rs1": 2, "rs2": 1, "rs3": 1},
+		},
+		{
+			description:    "zero",
+			maxUnavailable: intstr.FromString("0%"),
+			expected:       map[string]int{"rs1": 0, "rs2": 0, "rs3": 0},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx := context.Background()
+			owners := map[string][]*v1.Pod{
+				"rs1": ownedPods("rs1", 12),
+				"rs2": ownedPods("rs2", 3),
+				"rs3": ownedPods("rs3", 1),
+			}
+			objects := []runtime.Object{node1}
+			for _, pods := range owners {
+				for _, pod := range pods {
+					objects = append(objects, pod)
+				}
+			}
+			client := fakeclientset.NewSimpleClientset(objects...)
+			sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+			podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+			_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+			podEvictor, err := NewPodEvictor(
+				ctx,
+				client,
+				eventRecorder,
+				podInformer,
+				initFeatureGates(),
+				NewOptions().WithMaxUnavailablePerOwner(&tc.maxUnavailable),
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+			}
+			for _, obj := range objects[1:] {
+				podInformer.GetIndexer().Add(obj)
+			}
+
+			for owner, pods := range owners {
+				evicted := 0
+				for _, pod := range pods {
+					err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+					if err == nil {
+						evicted++
+						continue
+					}
+					if _, ok := err.(*EvictionOwnerLimitError); !ok {
+						t.Errorf("Expected an owner limit error when evicting %v, got %v", pod.Name, err)
+					}
+				}
+				if evicted != tc.expected[owner] {
+					t.Errorf("Expected %v pods of %v to be evicted, got %v", tc.expected[owner], owner, evicted)
+				}
+			}
+
+			// the limits are per a descheduling cycle
+			podEvictor.ResetCounters()
+			if tc.expected["rs1"] > 0 {
+				if err := podEvictor.EvictPod(ctx, owners["rs1"][0], EvictOptions{}); err != nil {
+					t.Errorf("Unexpected error when evicting in the next cycle: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestOwnerEvictionLimitsBase(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	ownerRef := metav1.OwnerReference{Kind: "ReplicaSet", APIVersion: "v1", Name: "rs1", UID: types.UID("rs1")}
+	buildPod := func(name string, apply func(*v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = []metav1.OwnerReference{ownerRef}
+			pod.Status.Phase = v1.PodRunning
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+
+	var active []*v1.Pod
+	objects := []runtime.Object{node1}
+	for i := 0; i < 10; i++ {
+		pod := buildPod(fmt.Sprintf("active-%v", i), nil)
+		active = append(active, pod)
+		objects = append(objects, pod)
+	}
+	// completed and terminating pods are not replicas of the owner
+	for i := 0; i < 4; i++ {
+		objects = append(objects, buildPod(fmt.Sprintf("completed-%v", i), func(pod *v1.Pod) {
+			pod.Status.Phase = v1.PodSucceeded
+		}))
+	}
+	for i := 0; i < 2; i++ {
+		objects = append(objects, buildPod(fmt.Sprintf("terminating-%v", i), func(pod *v1.Pod) {
+			pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}))
+	}
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	maxUnavailable := intstr.FromString("50%")
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithMaxUnavailablePerOwner(&maxUnavailable),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	for _, obj := range objects[1:] {
+		podInformer.GetIndexer().Add(obj)
+	}
+
+	for cycle := 0; cycle < 2; cycle++ {
+		podEvictor.ResetCounters()
+		evicted := 0
+		for _, pod := range active[cycle*5:] {
+			err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+			if err != nil {
+				if _, ok := err.(*EvictionOwnerLimitError); !ok {
+					t.Errorf("Expected an owner limit error when evicting %v, got %v", pod.Name, err)
+				}
+				continue
+			}
+			evicted++
+			// the evicted pods disappear from the informer during the cycle
+			podInformer.GetIndexer().Delete(pod)
+		}
+		// half of the 10 active pods in the first cycle, half of the 5 remaining ones in the next cycle
+		if expected := []int{5, 3}[cycle]; evicted != expected {
+			t.Errorf("Expected %v pods evicted in cycle %v, got %v", expected, cycle, evicted)
+		}
+	}
+}
+
+func TestPodDisruptionBudgetChecks(t *testing.T) {
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	buildPod := func(name, app string, ready bool, apply func(*v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.Labels = map[string]string{"app": app}
+			pod.Status.Phase = v1.PodRunning
+			if ready {
+				pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+			}
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+	buildPDB := func(name, app string, apply func(*policy.PodDisruptionBudget)) *policy.PodDisruptionBudget {
+		pdb := &policy.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1},
+			Spec: policy.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+			Status: policy.PodDisruptionBudgetStatus{ObservedGeneration: 1},
+		}
+		if apply != nil {
+			apply(pdb)
+		}
+		return pdb
+	}
+
+	pods := map[string]*v1.Pod{}
+	for _, pod := range []*v1.Pod{
+		buildPod("a1", "a", true, nil),
+		buildPod("a2", "a", true, nil),
+		buildPod("a3", "a", true, nil),
+		buildPod("a4", "a", true, nil),
+		buildPod("a-failing", "a", true, nil),
+		buildPod("a-unready", "a", false, nil),
+		buildPod("a-pending", "a", true, func(pod *v1.Pod) {
+			pod.Status.Phase = v1.PodPending
+		}),
+		buildPod("b-ready", "b", true, nil),
+		buildPod("b-unready", "b", false, nil),
+		buildPod("c1", "c", true, nil),
+		buildPod("d1", "d", true, nil),
+		buildPod("e1", "e", true, nil),
+	} {
+		pods[pod.Name] = pod
+	}
+	pdbs := []*policy.PodDisruptionBudget{
+		buildPDB("pdb-a", "a", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Status.DisruptionsAllowed = 2
+			pdb.Status.CurrentHealthy = 4
+			pdb.Status.DesiredHealthy = 2
+		}),
+		buildPDB("pdb-b", "b", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Spec.UnhealthyPodEvictionPolicy = utilptr.To(policy.AlwaysAllow)
+		}),
+		buildPDB("pdb-c", "c", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Generation = 2
+			pdb.Status.DisruptionsAllowed = 1
+		}),
+		buildPDB("pdb-d1", "d", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Status.DisruptionsAllowed = 1
+		}),
+		buildPDB("pdb-d2", "d", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Status.DisruptionsAllowed = 1
+		}),
+	}
+
+	client := fakeclientset.NewSimpleClientset(node1)
+	client.PrependReactor("create", "pods/eviction", func(action core.Action) (bool, runtime.Object, error) {
+		if action.(core.CreateAction).GetObject().(*policy.Eviction).Name == "a-failing" {
+			return true, nil, fmt.Errorf("eviction failed")
+		}
+		return true, nil, nil
+	})
+	sharedInformerFactory := informers.NewSharedInformerFactory(client, 0)
+	pdbInformer := sharedInformerFactory.Policy().V1().PodDisruptionBudgets()
+	for _, pdb := range pdbs {
+		pdbInformer.Informer().GetIndexer().Add(pdb)
+	}
+	_, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		client,
+		eventRecorder,
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions(),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	// no checks until the lister is set
+	if err := podEvictor.EvictPod(ctx, pods["b-ready"], EvictOptions{}); err != nil {
+		t.Errorf("Unexpected error when evicting with no lister set: %v", err)
+	}
+	podEvictor.SetPodDisruptionBudgetLister(pdbInformer.Lister())
+
+	evict := func(pod, expectedErr string) {
+		t.Helper()
+		err := podEvictor.EvictPod(ctx, pods[pod], EvictOptions{})
+		if expectedErr == "" {
+			if err != nil {
+				t.Errorf("Unexpected error when evicting %v: %v", pod, err)
+			}
+			return
+		}
+		if _, ok := err.(*EvictionPDBError); !ok || err.Error() != expectedErr {
+			t.Errorf("Expected %q error when evicting %v, got %v instead", expectedErr, pod, err)
+		}
+	}
+
+	// a failed eviction gives the disruption back
+	if err := podEvictor.EvictPod(ctx, pods["a-failing"], EvictOptions{}); err == nil || err.Error() != "eviction failed" {
+		t.Errorf("Expected the eviction of a-failing to fail, got %v", err)
+	}
+	evict("a1", "")
+	evict("a2", "")
+	evict("a3", "pod disruption budget default/pdb-a does not allow any more disruptions (2 allowed, 2 consumed in this cycle)")
+	// unhealthy pods do not consume the budget while it is healthy
+	evict("a-unready", "")
+	// pending pods are not subject to budgets
+	evict("a-pending", "")
+	evict("b-ready", "pod disruption budget default/pdb-b does not allow any more disruptions (0 allowed, 0 consumed in this cycle)")
+	evict("b-unready", "")
+	evict("c1", "pod disruption budget default/pdb-c is still being processed by the server")
+	evict("d1", "pod is covered by more than one pod disruption budget which the eviction API does not support")
+	evict("e1", "")
+
+	// the disruptions of the previous cycle are expected to be reflected in the budget status
+	podEvictor.ResetCounters()
+	evict("a3", "")
+
+	// the eviction recorded in the budget status does not consume the budget twice
+	pdbA := pdbs[0].DeepCopy()
+	pdbA.Status.DisruptionsAllowed = 1
+	pdbA.Status.DisruptedPods = map[string]metav1.Time{"a3": metav1.Now()}
+	pdbInformer.Informer().GetIndexer().Update(pdbA)
+	evict("a4", "")
+}
+
+func TestDryRunEvictionAPI(t *testing.T) {
+	ctx := context.Background()
+	buildPod := func(name, app string, ready bool) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, "n1", func(pod *v1.Pod) {
+			pod.Labels = map[string]string{"app": app}
+			pod.Status.Phase = v1.PodRunning
+			if ready {
+				pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+			}
+		})
+	}
+	buildPDB := func(name, app string, apply func(*policy.PodDisruptionBudget)) *policy.PodDisruptionBudget {
+		pdb := &policy.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: policy.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+		}
+		apply(pdb)
+		return pdb
+	}
+
+	client := fakeclientset.NewSimpleClientset(
+		buildPod("a1", "a", true),
+		buildPod("a2", "a", true),
+		buildPod("a3", "a", true),
+		buildPod("a4", "a", true),
+		buildPod("a-unready", "a", false),
+		buildPod("b1", "b", true),
+		buildPod("b2", "b", true),
+		buildPod("c1", "c", true),
+		buildPod("free", "free", true),
+		// budgets copied from a snapshot carry no status
+		buildPDB("pdb-a", "a", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Spec.MaxUnavailable = utilptr.To(intstr.FromString("40%"))
+		}),
+		buildPDB("pdb-b", "b", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Spec.MinAvailable = utilptr.To(intstr.FromInt32(2))
+		}),
+		buildPDB("pdb-c1", "c", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Spec.MinAvailable = utilptr.To(intstr.FromInt32(0))
+		}),
+		buildPDB("pdb-c2", "c", func(pdb *policy.PodDisruptionBudget) {
+			pdb.Spec.MinAvailable = utilptr.To(intstr.FromInt32(0))
+		}),
+	)
+	evictionAPI := NewDryRunEvictionAPI(client.Tracker())
+	if err := evictionAPI.SyncBudgets(); err != nil {
+		t.Fatalf("Unable to sync the budgets: %v", err)
+	}
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		return true, nil, evictionAPI.Evict(action.(core.CreateAction).GetObject().(*policy.Eviction))
+	})
+
+	budget := func(name string) policy.PodDisruptionBudgetStatus {
+		t.Helper()
+		pdb, err := client.PolicyV1().PodDisruptionBudgets("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unable to get pod disruption budget %v: %v", name, err)
+		}
+		return pdb.Status
+	}
+	evict := func(pod string) error {
+		return client.PolicyV1().Evictions("default").Evict(ctx, &policy.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod, Namespace: "default"}})
+	}
+	expectEvicted := func(pod string) {
+		t.Helper()
+		if err := evict(pod); err != nil {
+			t.Errorf("Unexpected error when evicting %v: %v", pod, err)
+		}
+		if _, err := client.CoreV1().Pods("default").Get(ctx, pod, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+			t.Errorf("Expected %v to be deleted, got %v", pod, err)
+		}
+	}
+	expectTooManyRequests := func(pod string) {
+		t.Helper()
+		err := evict(pod)
+		if !apierrors.IsTooManyRequests(err) {
+			t.Fatalf("Expected too many requests when evicting %v, got %v", pod, err)
+		}
+		if causes := err.(apierrors.APIStatus).Status().Details.Causes; len(causes) != 1 || causes[0].Type != policy.DisruptionBudgetCause {
+			t.Errorf("Expected the disruption budget cause, got %+v", causes)
+		}
+	}
+
+	// 5 pods expected, 40% of them may be unavailable, the unready pod is one of them
+	status := budget("pdb-a")
+	if status.ExpectedPods != 5 || status.CurrentHealthy != 4 || status.DesiredHealthy != 3 || status.DisruptionsAllowed != 1 {
+		t.Errorf("Unexpected status of pdb-a: %+v", status)
+	}
+	// unhealthy pods do not consume the budget while it is healthy
+	expectEvicted("a-unready")
+	expectEvicted("a1")
+	status = budget("pdb-a")
+	if _, ok := status.DisruptedPods["a1"]; status.DisruptionsAllowed != 0 || !ok {
+		t.Errorf("Expected the eviction of a1 to be recorded in pdb-a, got %+v", status)
+	}
+	expectTooManyRequests("a2")
+
+	expectTooManyRequests("b1")
+
+	if err := evict("c1"); !apierrors.IsInternalError(err) {
+		t.Errorf("Expected an internal error when evicting a pod covered by two budgets, got %v", err)
+	}
+	expectEvicted("free")
+	if err := evict("free"); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected not found when evicting a deleted pod, got %v", err)
+	}
+}
+
+func TestIsEvictionSkipError(t *testing.T) {
+	tests := []struct {
+		err  error
+		skip bool
+	}{
+		{err: NewEvictionNodeLimitError("n1"), skip: true},
+		{err: NewEvictionNamespaceLimitError("default"), skip: true},
+		{err: NewEvictionTotalLimitError(), skip: true},
+		{err: NewEvictionRateLimitError("default", time.Minute), skip: true},
+		{err: NewEvictionRateLimitError("", time.Minute), skip: true},
+		{err: &EvictionOwnerLimitError{owner: "default/ReplicaSet/rs"}, skip: true},
+		{err: &EvictionOwnerHeldError{owner: "default/ReplicaSet/rs"}, skip: true},
+		{err: &EvictionPDBError{pdb: "default/pdb", reason: "budget exhausted"}, skip: true},
+		{err: fmt.Errorf("connection refused"), skip: false},
+	}
+	for _, tc := range tests {
+		if got := IsEvictionSkipError(tc.err); got != tc.skip {
+			t.Errorf("Expected %T %q to be a skip error: %v, got %v", tc.err, tc.err, tc.skip, got)
+		}
+	}
+}
+
+func TestSkipResult(t *testing.T) {
+	tests := []struct {
+		err    error
+		result string
+	}{
+		{err: NewEvictionNodeLimitError("n1"), result: "maximum number of evicted pods per node reached"},
+		{err: &EvictionNodeLimitError{node: "n1", profile: "p1"}, result: "maximum number of evicted pods per node reached"},
+		{err: &EvictionNamespaceLimitError{namespace: "default", profile: "p1"}, result: "maximum number of evicted pods per namespace reached"},
+		{err: &EvictionTotalLimitError{profile: "p1"}, result: "maximum number of evicted pods per a descheduling cycle reached"},
+		{err: NewEvictionRateLimitError("default", time.Minute), result: "maximum number of evicted pods per rate limit window reached"},
+		{err: &EvictionOwnerLimitError{owner: "default/ReplicaSet/rs"}, result: "maximum number of evicted pods per owner reached"},
+		{err: &EvictionOwnerHeldError{owner: "default/ReplicaSet/rs"}, result: "eviction held until the ready replicas of the owner recover"},
+		{err: &EvictionPDBError{pdb: "default/pdb", reason: "budget exhausted"}, result: "pod disruption budget does not allow the eviction"},
+	}
+	for _, tc := range tests {
+		if got := skipResult(tc.err); got != tc.result {
+			t.Errorf("Expected %T %q to be counted with the %q result, got %q", tc.err, tc.err, tc.result, got)
+		}
+	}
+}
+
+func TestIsEvictionStopError(t *testing.T) {
+	tests := []struct {
+		err  error
+		stop bool
+	}{
+		{err: NewEvictionTotalLimitError(), stop: true},
+		{err: NewEvictionRateLimitError("", time.Minute), stop: true},
+		{err: NewEvictionRateLimitError("default", time.Minute), stop: false},
+		{err: NewEvictionNamespaceLimitError("default"), stop: false},
+		{err: NewEvictionNodeLimitError("n1"), stop: false},
+		{err: fmt.Errorf("connection refused"), stop: false},
+	}
+	for _, tc := range tests {
+		if got := IsEvictionStopError(tc.err); got != tc.stop {
+			t.Errorf("Expected %T %q to be a stop error: %v, got %v", tc.err, tc.err, tc.stop, got)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// evictionLimiter enforces the node, namespace and total eviction limits
+// and counts the evictions, including the evictions in progress.
+// The limiter is not thread safe, the pod evictor serializes the access.
+type evictionLimiter struct {
+	// profileName is empty for the global limits
+	profileName                string
+	maxPodsToEvictPerNode      *uint
+	maxPodsToEvictPerNamespace *uint
+	maxPodsToEvictTotal        *uint
+	nodePodCount               nodePodEvictedCount
+	namespacePodCount          namespacePodEvictCount
+	totalPodCount              uint
+	nodePodInFlight            nodePodEvictedCount
+	namespacePodInFlight       namespacePodEvictCount
+	totalPodInFlight           uint
+}
+
+func newEvictionLimiter(profileName string, maxPodsToEvictPerNode, maxPodsToEvictPerNamespace, maxPodsToEvictTotal *uint) *evictionLimiter {
+	return &evictionLimiter{
+		profileName:                profileName,
+		maxPodsToEvictPerNode:      maxPodsToEvictPerNode,
+		maxPodsToEvictPerNamespace: maxPodsToEvictPerNamespace,
+		maxPodsToEvictTotal:        maxPodsToEvictTotal,
+		nodePodCount:               make(nodePodEvictedCount),
+		namespacePodCount:          make(namespacePodEvictCount),
+		nodePodInFlight:            make(nodePodEvictedCount),
+		namespacePodInFlight:       make(namespacePodEvictCount),
+	}
+}
+
+// check returns an error when evicting the pod would exceed any of the limits.
+// Evictions requested in the background are passed in as they count against the limits too.
+func (l *evictionLimiter) check(pod *v1.Pod, totalRequests, nodeRequests, namespaceRequests uint) error {
+	if l.maxPodsToEvictTotal != nil && l.totalPodCount+l.totalPodInFlight+totalRequests+1 > *l.maxPodsToEvictTotal {
+		return &EvictionTotalLimitError{profile: l.profileName}
+	}
+	if pod.Spec.NodeName != "" {
+		if l.maxPodsToEvictPerNode != nil && l.nodePodCount[pod.Spec.NodeName]+l.nodePodInFlight[pod.Spec.NodeName]+nodeRequests+1 > *l.maxPodsToEvictPerNode {
+			return &EvictionNodeLimitError{node: pod.Spec.NodeName, profile: l.profileName}
+		}
+	}
+	if l.maxPodsToEvictPerNamespace != nil && l.namespacePodCount[pod.Namespace]+l.namespacePodInFlight[pod.Namespace]+namespaceRequests+1 > *l.maxPodsToEvictPerNamespace {
+		return &EvictionNamespaceLimitError{namespace: pod.Namespace, profile: l.profileName}
+	}
+	return nil
+}
+
+// limit returns the value of the limit the error was returned for
+func (l *evictionLimiter) limit(err error) uint {
+	var limit *uint
+	switch err.(type) {
+	case *EvictionTotalLimitError:
+		limit = l.maxPodsToEvictTotal
+	case *EvictionNodeLimitError:
+		limit = l.maxPodsToEvictPerNode
+	case *EvictionNamespaceLimitError:
+		limit = l.maxPodsToEvictPerNamespace
+	}
+	if limit == nil {
+		return 0
+	}
+	return *limit
+}
+
+// reserve reserves a slot for the pod in each of the limits
+func (l *evictionLimiter) reserve(pod *v1.Pod) {
+	if pod.Spec.NodeName != "" {
+		l.nodePodInFlight[pod.Spec.NodeName]++
+	}
+	l.namespacePodInFlight[pod.Namespace]++
+	l.totalPodInFlight++
+}
+
+// release returns the slots reserved for the pod
+func (l *evictionLimiter) release(pod *v1.Pod) {
+	if pod.Spec.NodeName != "" {
+		l.nodePodInFlight[pod.Spec.NodeName]--
+		if l.nodePodInFlight[pod.Spec.NodeName] == 0 {
+			delete(l.nodePodInFlight, pod.Spec.NodeName)
+		}
+	}
+	l.namespacePodInFlight[pod.Namespace]--
+	if l.namespacePodInFlight[pod.Namespace] == 0 {
+		delete(l.namespacePodInFlight, pod.Namespace)
+	}
+	l.totalPodInFlight--
+}
+
+// count counts the pod as evicted
+func (l *evictionLimiter) count(pod *v1.Pod) {
+	if pod.Spec.NodeName != "" {
+		l.nodePodCount[pod.Spec.NodeName]++
+	}
+	l.namespacePodCount[pod.Namespace]++
+	l.totalPodCount++
+}
+
+// reset resets the counters of evicted pods. Evictions in progress are kept.
+func (l *evictionLimiter) reset() {
+	l.nodePodCount = make(nodePodEvictedCount)
+	l.namespacePodCount = make(namespacePodEvictCount)
+	l.totalPodCount = 0
+}
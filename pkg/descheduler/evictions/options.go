@@ -1,7 +1,10 @@
 package evictions
 
 import (
+	"time"
+
 	policy "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type Options struct {
@@ -13,6 +16,11 @@ type Options struct {
 	evictionFailureEventNotification bool
 	metricsEnabled                   bool
 	gracePeriodSeconds               *int64
+	profiles                         map[string]*ProfileOptions
+	rateLimits                       []RateLimit
+	rateLimitStore                   RateLimitStore
+	rollingEvictionTimeout           *time.Duration
+	maxUnavailablePerOwner           *intstr.IntOrString
 }
 
 // NewOptions returns an Options with default values.
@@ -63,3 +71,73 @@ func (o *Options) WithEvictionFailureEventNotification(evictionFailureEventNotif
 	}
 	return o
 }
+
+// WithProfileOptions sets the eviction limits and the grace period of a profile.
+// The limits of a profile are enforced on top of the global limits.
+func (o *Options) WithProfileOptions(profileName string, profileOptions *ProfileOptions) *Options {
+	if o.profiles == nil {
+		o.profiles = make(map[string]*ProfileOptions)
+	}
+	o.profiles[profileName] = profileOptions
+	return o
+}
+
+// WithRateLimits limits the evictions within sliding time windows spanning descheduling cycles
+func (o *Options) WithRateLimits(rateLimits []RateLimit) *Options {
+	o.rateLimits = rateLimits
+	return o
+}
+
+// WithRateLimitStore persists the rate limit state so restarts do not reset the limits
+func (o *Options) WithRateLimitStore(rateLimitStore RateLimitStore) *Options {
+	o.rateLimitStore = rateLimitStore
+	return o
+}
+
+// WithRollingEvictions holds further evictions of pods of a ReplicaSet or a StatefulSet
+// until the ready replicas of the owner recover from the last eviction, at most for timeout
+func (o *Options) WithRollingEvictions(timeout time.Duration) *Options {
+	o.rollingEvictionTimeout = &timeout
+	return o
+}
+
+// WithMaxUnavailablePerOwner limits the number of evicted pods of each owner per a descheduling
+// cycle, as an absolute number or a percentage of the pods of the owner
+func (o *Options) WithMaxUnavailablePerOwner(maxUnavailablePerOwner *intstr.IntOrString) *Options {
+	o.maxUnavailablePerOwner = maxUnavailablePerOwner
+	return o
+}
+
+// ProfileOptions configures evictions of a single profile
+type ProfileOptions struct {
+	maxPodsToEvictPerNode      *uint
+	maxPodsToEvictPerNamespace *uint
+	maxPodsToEvictTotal        *uint
+	gracePeriodSeconds         *int64
+}
+
+// NewProfileOptions returns a ProfileOptions with no limits set.
+func NewProfileOptions() *ProfileOptions {
+	return &ProfileOptions{}
+}
+
+func (o *ProfileOptions) WithMaxPodsToEvictPerNode(maxPodsToEvictPerNode *uint) *ProfileOptions {
+	o.maxPodsToEvictPerNode = maxPodsToEvictPerNode
+	return o
+}
+
+func (o *ProfileOptions) WithMaxPodsToEvictPerNamespace(maxPodsToEvictPerNamespace *uint) *ProfileOptions {
+	o.maxPodsToEvictPerNamespace = maxPodsToEvictPerNamespace
+	return o
+}
+
+func (o *ProfileOptions) WithMaxPodsToEvictTotal(maxPodsToEvictTotal *uint) *ProfileOptions {
+	o.maxPodsToEvictTotal = maxPodsToEvictTotal
+	return o
+}
+
+// WithGracePeriodSeconds overrides the global grace period for evictions of the profile
+func (o *ProfileOptions) WithGracePeriodSeconds(gracePeriodSeconds *int64) *ProfileOptions {
+	o.gracePeriodSeconds = gracePeriodSeconds
+	return o
+}
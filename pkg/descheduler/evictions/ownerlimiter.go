@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// ownerEvictionLimiter limits the number of evicted pods of each owner per a
+// descheduling cycle. The replicas of an owner are counted from the owner
+// reference index of the pod informer.
+// The limiter is not thread safe, the pod evictor serializes the access.
+type ownerEvictionLimiter struct {
+	maxUnavailable intstr.IntOrString
+	pods           cache.Indexer
+	podCount       map[types.UID]uint
+	podInFlight    map[types.UID]uint
+	// limits of the owners computed in the current descheduling cycle, the
+	// evictions of the cycle must not shrink the base of the percentages
+	limits map[types.UID]uint
+}
+
+func newOwnerEvictionLimiter(maxUnavailable intstr.IntOrString, pods cache.Indexer) *ownerEvictionLimiter {
+	return &ownerEvictionLimiter{
+		maxUnavailable: maxUnavailable,
+		pods:           pods,
+		podCount:       make(map[types.UID]uint),
+		podInFlight:    make(map[types.UID]uint),
+		limits:         make(map[types.UID]uint),
+	}
+}
+
+// check returns an error when evicting the pod would exceed the limit of any
+// of its owners together with the value of the limit
+func (l *ownerEvictionLimiter) check(pod *v1.Pod) (uint, error) {
+	for _, ownerRef := range pod.OwnerReferences {
+		limit, err := l.limit(ownerRef.UID)
+		if err != nil {
+			klog.ErrorS(err, "Unable to compute the eviction limit of the owner", "pod", klog.KObj(pod), "owner", ownerRef.Name)
+			return 0, &EvictionOwnerLimitError{owner: pod.Namespace + "/" + ownerRef.Kind + "/" + ownerRef.Name}
+		}
+		if l.podCount[ownerRef.UID]+l.podInFlight[ownerRef.UID]+1 > limit {
+			return limit, &EvictionOwnerLimitError{owner: pod.Namespace + "/" + ownerRef.Kind + "/" + ownerRef.Name}
+		}
+	}
+	return 0, nil
+}
+
+// limit returns the maximum number of evicted pods of the owner, percentages
+// are scaled to the number of active pods of the owner when the owner is
+// first checked in the descheduling cycle and rounded up
+func (l *ownerEvictionLimiter) limit(ownerUID types.UID) (uint, error) {
+	if limit, ok := l.limits[ownerUID]; ok {
+		return limit, nil
+	}
+	objs, err := l.pods.ByIndex(podutil.OwnerRefUIDsIndex, string(ownerUID))
+	if err != nil {
+		return 0, err
+	}
+	active := 0
+	for _, obj := range objs {
+		pod, ok := obj.(*v1.Pod)
+		if !ok || utils.IsPodTerminating(pod) || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		active++
+	}
+	limit, err := intstr.GetScaledValueFromIntOrPercent(&l.maxUnavailable, active, true)
+	if err != nil {
+		return 0, err
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	l.limits[ownerUID] = uint(limit)
+	return uint(limit), nil
+}
+
+func (l *ownerEvictionLimiter) reserve(pod *v1.Pod) {
+	for _, ownerRef := range pod.OwnerReferences {
+		l.podInFlight[ownerRef.UID]++
+	}
+}
+
+func (l *ownerEvictionLimiter) release(pod *v1.Pod) {
+	for _, ownerRef := range pod.OwnerReferences {
+		l.podInFlight[ownerRef.UID]--
+		if l.podInFlight[ownerRef.UID] == 0 {
+			delete(l.podInFlight, ownerRef.UID)
+		}
+	}
+}
+
+func (l *ownerEvictionLimiter) count(pod *v1.Pod) {
+	for _, ownerRef := range pod.OwnerReferences {
+		l.podCount[ownerRef.UID]++
+	}
+}
+
+// reset resets the counters of evicted pods and the limits of the owners.
+// Evictions in progress are kept.
+func (l *ownerEvictionLimiter) reset() {
+	l.podCount = make(map[types.UID]uint)
+	l.limits = make(map[types.UID]uint)
+}
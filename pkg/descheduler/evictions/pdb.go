@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	policyv1listers "k8s.io/client-go/listers/policy/v1"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+)
+
+// pdbTracker checks the pod disruption budgets before the eviction API is called
+// and tracks the disruptions consumed within a descheduling cycle, so later
+// candidates see the reduced budgets before the budget status gets updated.
+// The checks mirror the ones of the eviction API.
+// The tracker is not thread safe, the pod evictor serializes the access.
+type pdbTracker struct {
+	lister policyv1listers.PodDisruptionBudgetLister
+	// consumed disruptions per budget in the current cycle, including evictions in progress
+	consumed map[string]int32
+	// budgets consumed by evictions in progress
+	reserved map[types.UID]string
+	// names of the pods evicted in the current cycle per budget
+	evicted map[string]sets.Set[string]
+}
+
+func newPDBTracker() *pdbTracker {
+	return &pdbTracker{
+		consumed: make(map[string]int32),
+		reserved: make(map[types.UID]string),
+		evicted:  make(map[string]sets.Set[string]),
+	}
+}
+
+// check returns an error when the eviction of the pod would violate its pod disruption
+// budget. Otherwise the key of the budget the eviction consumes a disruption of is
+// returned, empty when the eviction does not consume any.
+func (t *pdbTracker) check(pod *v1.Pod) (string, error) {
+	if t.lister == nil || canIgnorePDB(pod) {
+		return "", nil
+	}
+	pdbs, err := t.podPDBs(pod)
+	if err != nil {
+		return "", err
+	}
+	if len(pdbs) == 0 {
+		return "", nil
+	}
+	if len(pdbs) > 1 {
+		return "", &EvictionPDBError{reason: "pod is covered by more than one pod disruption budget which the eviction API does not support"}
+	}
+	pdb := pdbs[0]
+	key := pdb.Namespace + "/" + pdb.Name
+	// the eviction API records the disrupted pods in the budget status as it
+	// decrements the allowed disruptions, these are not consumed twice
+	consumed := t.consumed[key] - int32(t.evicted[key].Intersection(sets.KeySet(pdb.Status.DisruptedPods)).Len())
+
+	// unhealthy pods do not consume the budget when the policy allows their eviction
+	if !podutil.IsPodReady(pod) {
+		if pdb.Spec.UnhealthyPodEvictionPolicy != nil && *pdb.Spec.UnhealthyPodEvictionPolicy == policy.AlwaysAllow {
+			return "", nil
+		}
+		if pdb.Status.DesiredHealthy > 0 && pdb.Status.CurrentHealthy-consumed >= pdb.Status.DesiredHealthy {
+			return "", nil
+		}
+	}
+
+	if pdb.Status.ObservedGeneration < pdb.Generation {
+		return "", &EvictionPDBError{pdb: key, reason: fmt.Sprintf("pod disruption budget %s is still being processed by the server", key)}
+	}
+	if pdb.Status.DisruptionsAllowed-consumed <= 0 {
+		return "", &EvictionPDBError{pdb: key, reason: fmt.Sprintf("pod disruption budget %s does not allow any more disruptions (%d allowed, %d consumed in this cycle)", key, pdb.Status.DisruptionsAllowed, consumed)}
+	}
+	return key, nil
+}
+
+// podPDBs returns the budgets selecting the pod
+func (t *pdbTracker) podPDBs(pod *v1.Pod) ([]*policy.PodDisruptionBudget, error) {
+	list, err := t.lister.PodDisruptionBudgets(pod.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var pdbs []*policy.PodDisruptionBudget
+	for _, pdb := range list {
+		if selectsPod(pdb, pod) {
+			pdbs = append(pdbs, pdb)
+		}
+	}
+	return pdbs, nil
+}
+
+// selectsPod returns true when the budget selects the pod. Budgets with
+// a nil or empty selector select no pods.
+func selectsPod(pdb *policy.PodDisruptionBudget, pod *v1.Pod) bool {
+	if pdb.Spec.Selector == nil || pdb.Namespace != pod.Namespace {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	return err == nil && !selector.Empty() && selector.Matches(labels.Set(pod.Labels))
+}
+
+// canIgnorePDB returns true for pods the eviction API evicts regardless of their budgets
+func canIgnorePDB(pod *v1.Pod) bool {
+	return pod.DeletionTimestamp != nil ||
+		pod.Status.Phase == v1.PodSucceeded ||
+		pod.Status.Phase == v1.PodFailed ||
+		pod.Status.Phase == v1.PodPending
+}
+
+func (t *pdbTracker) reserve(pod *v1.Pod, key string) {
+	if key == "" {
+		return
+	}
+	t.consumed[key]++
+	t.reserved[pod.UID] = key
+}
+
+// release gives the disruption back unless the pod got evicted
+func (t *pdbTracker) release(pod *v1.Pod, evicted bool) {
+	key, ok := t.reserved[pod.UID]
+	if !ok {
+		return
+	}
+	delete(t.reserved, pod.UID)
+	if !evicted {
+		t.consumed[key]--
+		return
+	}
+	if t.evicted[key] == nil {
+		t.evicted[key] = sets.New[string]()
+	}
+	t.evicted[key].Insert(pod.Name)
+}
+
+// reset forgets the disruptions consumed in the previous cycle as they are
+// reflected in the budget status by now. Evictions in progress are kept.
+func (t *pdbTracker) reset() {
+	t.consumed = make(map[string]int32)
+	t.evicted = make(map[string]sets.Set[string])
+	for _, key := range t.reserved {
+		t.consumed[key]++
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// RateLimit limits the number of evictions within a sliding time window
+type RateLimit struct {
+	// PerNamespace counts the evictions in each namespace separately
+	PerNamespace bool
+	MaxEvictions uint
+	Window       time.Duration
+}
+
+// RateLimitState holds the times of past evictions per namespace, oldest first
+type RateLimitState map[string][]time.Time
+
+// RateLimitStore persists the rate limit state across restarts of the descheduler
+type RateLimitStore interface {
+	Load(ctx context.Context) (RateLimitState, error)
+	// Save persists the state, the evictions stored before since are outside of all the windows and dropped
+	Save(ctx context.Context, state RateLimitState, since time.Time) error
+}
+
+// evictionRateLimiter enforces the rate limits over the log of past evictions.
+// Unlike the eviction limiter it is not reset between descheduling cycles.
+// The limiter is not thread safe, the pod evictor serializes the access.
+type evictionRateLimiter struct {
+	limits    []RateLimit
+	maxWindow time.Duration
+	now       func() time.Time
+	evictions RateLimitState
+	// evictions in progress per namespace
+	inFlight      map[string]uint
+	totalInFlight uint
+	// changed is set when evictions were recorded since the state was last saved
+	changed bool
+}
+
+func newEvictionRateLimiter(limits []RateLimit) *evictionRateLimiter {
+	rl := &evictionRateLimiter{
+		limits:    limits,
+		now:       time.Now,
+		evictions: RateLimitState{},
+		inFlight:  map[string]uint{},
+	}
+	for _, limit := range limits {
+		if limit.Window > rl.maxWindow {
+			rl.maxWindow = limit.Window
+		}
+	}
+	return rl
+}
+
+// check returns an error when evicting the pod would exceed any of the rate limits
+// together with the maximum number of evictions of the limit
+func (rl *evictionRateLimiter) check(pod *v1.Pod) (uint, error) {
+	now := rl.now()
+	for _, limit := range rl.limits {
+		since := now.Add(-limit.Window)
+		var evicted uint
+		if limit.PerNamespace {
+			evicted = countSince(rl.evictions[pod.Namespace], since) + rl.inFlight[pod.Namespace]
+		} else {
+			evicted = rl.totalInFlight
+			for _, times := range rl.evictions {
+				evicted += countSince(times, since)
+			}
+		}
+		if evicted+1 > limit.MaxEvictions {
+			if limit.PerNamespace {
+				return limit.MaxEvictions, &EvictionRateLimitError{namespace: pod.Namespace, window: limit.Window}
+			}
+			return limit.MaxEvictions, &EvictionRateLimitError{window: limit.Window}
+		}
+	}
+	return 0, nil
+}
+
+// countSince returns the number of the times after since
+func countSince(times []time.Time, since time.Time) uint {
+	idx := sort.Search(len(times), func(i int) bool {
+		return times[i].After(since)
+	})
+	return uint(len(times) - idx)
+}
+
+func (rl *evictionRateLimiter) reserve(pod *v1.Pod) {
+	rl.inFlight[pod.Namespace]++
+	rl.totalInFlight++
+}
+
+func (rl *evictionRateLimiter) release(pod *v1.Pod) {
+	rl.inFlight[pod.Namespace]--
+	if rl.inFlight[pod.Namespace] == 0 {
+		delete(rl.inFlight, pod.Namespace)
+	}
+	rl.totalInFlight--
+}
+
+// count records the eviction of the pod and drops the evictions outside of all the windows
+func (rl *evictionRateLimiter) count(pod *v1.Pod) {
+	now := rl.now()
+	rl.evictions[pod.Namespace] = append(rl.evictions[pod.Namespace], now)
+	rl.prune(now)
+	rl.changed = true
+}
+
+func (rl *evictionRateLimiter) prune(now time.Time) {
+	since := now.Add(-rl.maxWindow)
+	for namespace, times := range rl.evictions {
+		kept := countSince(times, since)
+		if kept == 0 {
+			delete(rl.evictions, namespace)
+			continue
+		}
+		rl.evictions[namespace] = times[len(times)-int(kept):]
+	}
+}
+
+// since returns the time the oldest eviction within any of the windows happened after
+func (rl *evictionRateLimiter) since() time.Time {
+	return rl.now().Add(-rl.maxWindow)
+}
+
+// state returns a copy of the evictions within the windows
+func (rl *evictionRateLimiter) state() RateLimitState {
+	rl.prune(rl.now())
+	state := RateLimitState{}
+	for namespace, times := range rl.evictions {
+		state[namespace] = append([]time.Time{}, times...)
+	}
+	return state
+}
+
+// restore replaces the evictions with the given state
+func (rl *evictionRateLimiter) restore(state RateLimitState) {
+	rl.evictions = RateLimitState{}
+	for namespace, times := range state {
+		sorted := append([]time.Time{}, times...)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Before(sorted[j])
+		})
+		rl.evictions[namespace] = sorted
+	}
+	rl.prune(rl.now())
+	rl.changed = false
+}
+
+// rateLimitStateKey is the key of the config map data holding the state
+const rateLimitStateKey = "evictions"
+
+type configMapRateLimitStore struct {
+	client          clientset.Interface
+	namespace, name string
+}
+
+// NewConfigMapRateLimitStore returns a store keeping the rate limit state in a config map.
+// The config map is created when it does not exist.
+func NewConfigMapRateLimitStore(client clientset.Interface, namespace, name string) RateLimitStore {
+	return &configMapRateLimitStore{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (s *configMapRateLimitStore) Load(ctx context.Context) (RateLimitState, error) {
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return RateLimitState{}, nil
+		}
+		return nil, fmt.Errorf("unable to get %s/%s config map: %v", s.namespace, s.name, err)
+	}
+	state := RateLimitState{}
+	if data, ok := cm.Data[rateLimitStateKey]; ok {
+		if err := json.Unmarshal([]byte(data), &state); err != nil {
+			return nil, fmt.Errorf("unable to decode the state in %s/%s config map: %v", s.namespace, s.name, err)
+		}
+	}
+	return state, nil
+}
+
+// Save merges the state with the state stored, e.g. by another instance of the descheduler, and retries on conflicts
+func (s *configMapRateLimitStore) Save(ctx context.Context, state RateLimitState, since time.Time) error {
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		// another instance may create the config map in the meantime
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			data, err := json.Marshal(mergeRateLimitStates(since, state))
+			if err != nil {
+				return err
+			}
+			cm = &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.name},
+				Data:       map[string]string{rateLimitStateKey: string(data)},
+			}
+			_, err = s.client.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		stored := RateLimitState{}
+		if data, ok := cm.Data[rateLimitStateKey]; ok {
+			if err := json.Unmarshal([]byte(data), &stored); err != nil {
+				klog.ErrorS(err, "unable to decode the rate limit state stored, overwriting it", "configMap", klog.KObj(cm))
+				stored = RateLimitState{}
+			}
+		}
+		data, err := json.Marshal(mergeRateLimitStates(since, stored, state))
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[rateLimitStateKey] = string(data)
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to save the state in %s/%s config map: %v", s.namespace, s.name, err)
+	}
+	return nil
+}
+
+// mergeRateLimitStates returns the union of the evictions of the states after since, oldest first.
+// The evictions loaded from the store and saved again are kept once, i.e. an eviction time is kept
+// as many times as it is found in any single state.
+func mergeRateLimitStates(since time.Time, states ...RateLimitState) RateLimitState {
+	counts := map[string]map[int64]int{}
+	for _, state := range states {
+		for namespace, times := range state {
+			stateCounts := map[int64]int{}
+			for _, t := range times {
+				if t.After(since) {
+					stateCounts[t.UnixNano()]++
+				}
+			}
+			if counts[namespace] == nil {
+				counts[namespace] = map[int64]int{}
+			}
+			for t, count := range stateCounts {
+				counts[namespace][t] = max(counts[namespace][t], count)
+			}
+		}
+	}
+	merged := RateLimitState{}
+	for namespace, timeCounts := range counts {
+		var times []time.Time
+		for t, count := range timeCounts {
+			for i := 0; i < count; i++ {
+				times = append(times, time.Unix(0, t).UTC())
+			}
+		}
+		if len(times) == 0 {
+			continue
+		}
+		sort.Slice(times, func(i, j int) bool {
+			return times[i].Before(times[j])
+		})
+		merged[namespace] = times
+	}
+	return merged
+}
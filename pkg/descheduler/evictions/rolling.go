@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+)
+
+// DefaultRollingEvictionTimeout is the time evictions of an owner are held at most
+const DefaultRollingEvictionTimeout = 10 * time.Minute
+
+// rollout is the last eviction of a pod of an owner
+type rollout struct {
+	// evicted pod is not counted even though the informer may not reflect the eviction yet
+	evicted types.UID
+	// readyReplicas is the number of ready pods of the owner before the eviction
+	readyReplicas int
+	evictedAt     time.Time
+}
+
+// rollingTracker holds evictions of pods of an owner until the ready replicas
+// of the owner recover from the previous eviction. The replicas are counted
+// from the pod informer of the pod evictor, or from the copy of the cluster
+// the evictions are simulated on in the dry run mode.
+// The tracker is not thread safe, the pod evictor serializes the access.
+type rollingTracker struct {
+	timeout time.Duration
+	now     func() time.Time
+	pods    cache.Indexer
+	// rollouts waiting for the ready replicas to recover, by owner
+	rollouts map[string]*rollout
+	// owners with an eviction in progress
+	inFlight map[string]uint
+}
+
+func newRollingTracker(timeout time.Duration, pods cache.Indexer) *rollingTracker {
+	return &rollingTracker{
+		timeout:  timeout,
+		now:      time.Now,
+		pods:     pods,
+		rollouts: map[string]*rollout{},
+		inFlight: map[string]uint{},
+	}
+}
+
+// setPods switches the pods the ready replicas are counted from. The pods
+// evicted are no longer excluded: a fresh copy of the cluster holds them
+// again, standing for their replacements.
+func (rt *rollingTracker) setPods(pods cache.Indexer) {
+	if rt.pods == pods {
+		return
+	}
+	rt.pods = pods
+	for _, r := range rt.rollouts {
+		r.evicted = ""
+	}
+}
+
+// rollingOwner returns the ReplicaSet or StatefulSet owning the pod
+func rollingOwner(pod *v1.Pod) *metav1.OwnerReference {
+	for i := range pod.OwnerReferences {
+		if kind := pod.OwnerReferences[i].Kind; kind == "ReplicaSet" || kind == "StatefulSet" {
+			return &pod.OwnerReferences[i]
+		}
+	}
+	return nil
+}
+
+// ownerKey identifies the owner of a pod as namespace/kind/name
+func ownerKey(namespace string, owner *metav1.OwnerReference) string {
+	return namespace + "/" + owner.Kind + "/" + owner.Name
+}
+
+// check returns an error when an eviction of a pod of the same owner is
+// in progress or its replacement is not ready yet
+func (rt *rollingTracker) check(pod *v1.Pod) error {
+	owner := rollingOwner(pod)
+	if owner == nil {
+		return nil
+	}
+	key := ownerKey(pod.Namespace, owner)
+	if rt.inFlight[key] > 0 {
+		return &EvictionOwnerHeldError{owner: key}
+	}
+	rt.prune()
+	r, ok := rt.rollouts[key]
+	if !ok {
+		return nil
+	}
+	if ready := rt.readyReplicas(pod.Namespace, owner, r.evicted); ready < r.readyReplicas {
+		klog.V(3).InfoS("Holding eviction until the ready replicas of the owner recover", "pod", klog.KObj(pod), "owner", key, "readyReplicas", ready, "expectedReadyReplicas", r.readyReplicas)
+		return &EvictionOwnerHeldError{owner: key}
+	}
+	delete(rt.rollouts, key)
+	return nil
+}
+
+// prune drops the rollouts which timed out
+func (rt *rollingTracker) prune() {
+	now := rt.now()
+	for key, r := range rt.rollouts {
+		if now.Sub(r.evictedAt) >= rt.timeout {
+			klog.V(3).InfoS("Ready replicas of the owner did not recover in time, evictions no longer held", "owner", key, "timeout", rt.timeout)
+			delete(rt.rollouts, key)
+		}
+	}
+}
+
+func (rt *rollingTracker) reserve(pod *v1.Pod) {
+	if owner := rollingOwner(pod); owner != nil {
+		rt.inFlight[ownerKey(pod.Namespace, owner)]++
+	}
+}
+
+// release ends the eviction in progress. The ready replicas of the owner are
+// expected to recover when the pod got evicted.
+func (rt *rollingTracker) release(pod *v1.Pod, evicted bool) {
+	owner := rollingOwner(pod)
+	if owner == nil {
+		return
+	}
+	key := ownerKey(pod.Namespace, owner)
+	rt.inFlight[key]--
+	if rt.inFlight[key] == 0 {
+		delete(rt.inFlight, key)
+	}
+	if !evicted {
+		return
+	}
+	ready := rt.readyReplicas(pod.Namespace, owner, pod.UID)
+	if podutil.IsPodReady(pod) {
+		ready++
+	}
+	rt.rollouts[key] = &rollout{
+		evicted:       pod.UID,
+		readyReplicas: ready,
+		evictedAt:     rt.now(),
+	}
+}
+
+// readyReplicas counts the ready pods of the owner which are not being deleted, except the excluded one
+func (rt *rollingTracker) readyReplicas(namespace string, owner *metav1.OwnerReference, exclude types.UID) int {
+	objs, err := rt.pods.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		klog.ErrorS(err, "Unable to list pods", "namespace", namespace)
+		return 0
+	}
+	ready := 0
+	for _, obj := range objs {
+		pod, ok := obj.(*v1.Pod)
+		if !ok || pod.UID == exclude || pod.DeletionTimestamp != nil || !podutil.IsPodReady(pod) {
+			continue
+		}
+		if podOwner := rollingOwner(pod); podOwner != nil && podOwner.Kind == owner.Kind && podOwner.Name == owner.Name {
+			ready++
+		}
+	}
+	return ready
+}
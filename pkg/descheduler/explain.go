@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// Explain tells why the profiles of the policy would or would not evict the pod.
+// The cluster is listed once and every deschedule and balance plugin runs alone
+// in the dry run mode over its own copy, so the plugins do not affect each other.
+func Explain(ctx context.Context, rs *options.DeschedulerServer, namespace, name string) (*simulation.Explanation, error) {
+	deschedulerPolicy, _, err := setup(ctx, rs)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := clusterObjects(ctx, rs.Client, append(informedResources(deschedulerPolicy), snapshotResources...))
+	if err != nil {
+		return nil, err
+	}
+	return explain(ctx, rs, objects, deschedulerPolicy, namespace, name)
+}
+
+func explain(ctx context.Context, rs *options.DeschedulerServer, objects []runtime.Object, deschedulerPolicy *api.DeschedulerPolicy, namespace, name string) (*simulation.Explanation, error) {
+	var explanation *simulation.Explanation
+	var explainErr error
+	err := runOverObjects(ctx, rs, objects, deschedulerPolicy, func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+		explanation, explainErr = d.explainFilters(ctx, nodes, namespace, name)
+		return explainErr
+	})
+	if err == nil {
+		err = explainErr
+	}
+	if err == nil && explanation == nil {
+		err = fmt.Errorf("the descheduling cycle did not run")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, profile := range deschedulerPolicy.Profiles {
+		for _, extensionPoint := range []frameworktypes.ExtensionPoint{frameworktypes.DescheduleExtensionPoint, frameworktypes.BalanceExtensionPoint} {
+			for _, plugin := range enabledPlugins(profile, extensionPoint) {
+				var reports []*frameworktypes.CycleReport
+				var cycleErr error
+				err := runOverObjects(ctx, rs, objects, singlePluginPolicy(deschedulerPolicy, idx, extensionPoint, plugin), func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+					if cycleErr = d.runDeschedulerLoop(ctx, nodes); cycleErr != nil {
+						return cycleErr
+					}
+					reports = d.reports
+					return nil
+				})
+				if err == nil {
+					err = cycleErr
+				}
+				if err != nil {
+					return nil, fmt.Errorf("unable to run plugin %s of profile %s: %v", plugin, profile.Name, err)
+				}
+				explanation.Profiles[idx].Plugins = append(explanation.Profiles[idx].Plugins, simulation.PluginSelection(plugin, extensionPoint, namespace, name, reports))
+			}
+		}
+	}
+	return explanation, nil
+}
+
+// explainFilters collects the verdicts of the evictor plugins of every profile on the pod
+// and checks whether the pod fits each of the nodes
+func (d *descheduler) explainFilters(ctx context.Context, nodes []*v1.Node, namespace, name string) (*simulation.Explanation, error) {
+	pod, err := d.sharedInformerFactory.Core().V1().Pods().Lister().Pods(namespace).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pod %s/%s: %v", namespace, name, err)
+	}
+	explanation := &simulation.Explanation{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Node:      pod.Spec.NodeName,
+		Profiles:  []simulation.ProfileExplanation{},
+		NodeFit:   []simulation.NodeFit{},
+	}
+
+	for _, profile := range d.deschedulerPolicy.Profiles {
+		profileR, err := d.buildProfileRunner(ctx, profile, d.handleOptions(d.rs.Client)...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create profile %s: %v", profile.Name, err)
+		}
+		if err := profileR.beforeCycle(ctx); err != nil {
+			return nil, fmt.Errorf("unable to prepare profile %s: %v", profile.Name, err)
+		}
+		explanation.Profiles = append(explanation.Profiles, simulation.ProfileExplanation{
+			Profile:           profile.Name,
+			NodeSelected:      profileR.nodeSelector == nil || profileR.nodeSelector.Matches(labels.Set(nodeLabels(nodes, pod.Spec.NodeName))),
+			Filter:            simulation.Verdicts(profileR.evictor.FilterVerdicts(pod)),
+			PreEvictionFilter: simulation.Verdicts(profileR.evictor.PreEvictionFilterVerdicts(pod)),
+			Plugins:           []simulation.PluginExplanation{},
+		})
+		profileR.afterCycle(ctx)
+		if err := profileR.close(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, node := range nodes {
+		nodeFit := simulation.NodeFit{Node: node.Name, Fits: true}
+		if err := nodeutil.NodeFit(d.getPodsAssignedToNode, pod, node); err != nil {
+			nodeFit.Fits = false
+			nodeFit.Reason = err.Error()
+		}
+		explanation.NodeFit = append(explanation.NodeFit, nodeFit)
+	}
+	sort.Slice(explanation.NodeFit, func(i, j int) bool {
+		return explanation.NodeFit[i].Node < explanation.NodeFit[j].Node
+	})
+	return explanation, nil
+}
+
+func nodeLabels(nodes []*v1.Node, nodeName string) map[string]string {
+	for _, node := range nodes {
+		if node.Name == nodeName {
+			return node.Labels
+		}
+	}
+	return nil
+}
+
+func enabledPlugins(profile api.DeschedulerProfile, extensionPoint frameworktypes.ExtensionPoint) []string {
+	if extensionPoint == frameworktypes.DescheduleExtensionPoint {
+		return profile.Plugins.Deschedule.Enabled
+	}
+	return profile.Plugins.Balance.Enabled
+}
+
+// singlePluginPolicy returns a copy of the policy with the profile only, running the plugin only
+// at the extension point. The evictor, sort and post eviction plugins of the profile are kept.
+func singlePluginPolicy(deschedulerPolicy *api.DeschedulerPolicy, profileIdx int, extensionPoint frameworktypes.ExtensionPoint, plugin string) *api.DeschedulerPolicy {
+	policy := deschedulerPolicy.DeepCopy()
+	profile := policy.Profiles[profileIdx]
+	profile.Plugins.Deschedule = api.PluginSet{}
+	profile.Plugins.Balance = api.PluginSet{}
+	if extensionPoint == frameworktypes.DescheduleExtensionPoint {
+		profile.Plugins.Deschedule.Enabled = []string{plugin}
+	} else {
+		profile.Plugins.Balance.Enabled = []string{plugin}
+	}
+	policy.Profiles = []api.DeschedulerProfile{profile}
+	return policy
+}
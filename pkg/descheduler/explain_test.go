@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestExplain(t *testing.T) {
+	initPluginRegistry()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	owned := test.BuildTestPod("owned", 100, 0, node1.Name, test.SetRSOwnerRef)
+	naked := test.BuildTestPod("naked", 100, 0, node1.Name, nil)
+	objects := []runtime.Object{node1, node2, owned, naked}
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.DefaultFeatureGates = initFeatureGates()
+
+	t.Run("evictable pod", func(t *testing.T) {
+		explanation, err := explain(context.Background(), rs, objects, removePodsViolatingNodeTaintsPolicy(), owned.Namespace, owned.Name)
+		if err != nil {
+			t.Fatalf("Unable to explain the pod: %v", err)
+		}
+		if explanation.Node != node1.Name || len(explanation.Profiles) != 1 {
+			t.Fatalf("Unexpected explanation %+v", explanation)
+		}
+		profile := explanation.Profiles[0]
+		if !profile.NodeSelected {
+			t.Errorf("Expected the node of the pod to be selected")
+		}
+		if len(profile.Filter) != 1 || !profile.Filter[0].Allowed {
+			t.Errorf("Expected the pod to pass the filter, got %+v", profile.Filter)
+		}
+		if len(profile.Plugins) != 1 || !profile.Plugins[0].Selected || profile.Plugins[0].ExtensionPoint != frameworktypes.DescheduleExtensionPoint {
+			t.Errorf("Expected the pod to be selected by the taint plugin, got %+v", profile.Plugins)
+		}
+		if len(explanation.NodeFit) != 2 {
+			t.Fatalf("Expected the node fit of 2 nodes, got %+v", explanation.NodeFit)
+		}
+		if explanation.NodeFit[0].Fits || explanation.NodeFit[0].Reason == "" {
+			t.Errorf("Expected the pod not to fit the tainted node, got %+v", explanation.NodeFit[0])
+		}
+		if !explanation.NodeFit[1].Fits {
+			t.Errorf("Expected the pod to fit the untainted node, got %+v", explanation.NodeFit[1])
+		}
+	})
+
+	t.Run("pod rejected by the evictor", func(t *testing.T) {
+		explanation, err := explain(context.Background(), rs, objects, removePodsViolatingNodeTaintsPolicy(), naked.Namespace, naked.Name)
+		if err != nil {
+			t.Fatalf("Unable to explain the pod: %v", err)
+		}
+		profile := explanation.Profiles[0]
+		if len(profile.Filter) != 1 || profile.Filter[0].Allowed || len(profile.Filter[0].Reasons) == 0 ||
+			!strings.HasPrefix(profile.Filter[0].Reasons[0], string(defaultevictor.ReasonNoOwnerReferences)) {
+			t.Errorf("Expected the pod to be rejected for having no owner, got %+v", profile.Filter)
+		}
+		if len(profile.Plugins) != 1 || profile.Plugins[0].Selected {
+			t.Errorf("Expected the pod not to be selected, got %+v", profile.Plugins)
+		}
+	})
+
+	t.Run("missing pod", func(t *testing.T) {
+		if _, err := explain(context.Background(), rs, objects, removePodsViolatingNodeTaintsPolicy(), "default", "missing"); err == nil {
+			t.Errorf("Expected an error explaining a missing pod")
+		}
+	})
+}
@@ -107,6 +107,13 @@ func (mc *MetricsCollector) NodeUsage(node *v1.Node) (api.ReferencedResourceList
 	}, nil
 }
 
+// SetNodeSelector changes the nodes the metrics are collected for, the usage collected so far is kept
+func (mc *MetricsCollector) SetNodeSelector(nodeSelector labels.Selector) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.nodeSelector = nodeSelector
+}
+
 func (mc *MetricsCollector) HasSynced() bool {
 	return mc.hasSynced
 }
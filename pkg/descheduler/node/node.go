@@ -187,6 +187,20 @@ func PodFitsAnyOtherNode(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.
 	})
 }
 
+// FittingOtherNode returns the first of the given nodes, besides the node the pod is already
+// running on, the given pod fits on. Returns nil when the pod does not fit any of the nodes.
+func FittingOtherNode(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.Pod, nodes []*v1.Node) *v1.Node {
+	for _, node := range nodes {
+		if node.Name == pod.Spec.NodeName {
+			continue
+		}
+		if err := NodeFit(nodeIndexer, pod, node); err == nil {
+			return node
+		}
+	}
+	return nil
+}
+
 // PodFitsAnyNode checks if the given pod will fit any of the given nodes. The predicates used
 // to determine if the pod will fit can be found in the NodeFit function.
 func PodFitsAnyNode(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.Pod, nodes []*v1.Node) bool {
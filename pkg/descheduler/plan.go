@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
+)
+
+// GeneratePlan runs a single descheduling cycle of all the profiles in the dry run mode
+// and returns the evictions made as a plan which can be reviewed and applied later.
+func GeneratePlan(ctx context.Context, rs *options.DeschedulerServer) (*plan.File, error) {
+	deschedulerPolicy, evictionPolicyGroupVersion, err := setup(ctx, rs)
+	if err != nil {
+		return nil, err
+	}
+	return generatePlan(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion)
+}
+
+func generatePlan(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string) (*plan.File, error) {
+	rs.DryRun = true
+	rs.DeschedulingInterval = 0
+
+	var file *plan.File
+	var cycleErr error
+	err := runDeschedulerStrategies(ctx, rs, withoutSchedules(deschedulerPolicy), evictionPolicyGroupVersion, func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+		if cycleErr = d.runDeschedulerLoop(ctx, nodes); cycleErr != nil {
+			return cycleErr
+		}
+		file = d.evictionPlan(nodes)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cycleErr != nil {
+		return nil, cycleErr
+	}
+	if file == nil {
+		return nil, fmt.Errorf("the descheduling cycle did not run")
+	}
+	return file, nil
+}
+
+// ApplyPlan evicts the pods of the plan which are still valid in the cluster.
+// Each entry is checked against the live state and evicted through the
+// evictor of its profile, so the eviction limits of the policy apply.
+func ApplyPlan(ctx context.Context, rs *options.DeschedulerServer, file *plan.File) ([]plan.EntryResult, error) {
+	deschedulerPolicy, evictionPolicyGroupVersion, err := setup(ctx, rs)
+	if err != nil {
+		return nil, err
+	}
+	rs.DryRun = false
+	rs.DeschedulingInterval = 0
+
+	var results []plan.EntryResult
+	err = runDeschedulerStrategies(ctx, rs, withoutSchedules(deschedulerPolicy), evictionPolicyGroupVersion, func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+		results = d.applyPlan(ctx, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		return nil, fmt.Errorf("the plan was not applied")
+	}
+	return results, nil
+}
+
+// withoutSchedules returns a copy of the policy running all the profiles in a single cycle
+func withoutSchedules(deschedulerPolicy *api.DeschedulerPolicy) *api.DeschedulerPolicy {
+	policy := deschedulerPolicy.DeepCopy()
+	for idx := range policy.Profiles {
+		policy.Profiles[idx].Schedule = nil
+	}
+	return policy
+}
+
+// evictionPlan turns the pods evicted in the last dry run cycle into plan entries.
+// The target node is estimated over the copy of the cluster the pods were evicted from.
+func (d *descheduler) evictionPlan(nodes []*v1.Node) *plan.File {
+	podLister := d.ir.sharedInformerFactory.Core().V1().Pods().Lister()
+	entries := plan.EntriesFromReports(d.reports)
+	for idx, entry := range entries {
+		if pod, err := podLister.Pods(entry.Namespace).Get(entry.Name); err == nil && pod.UID == entry.UID {
+			if node := nodeutil.FittingOtherNode(d.getPodsAssignedToNode, pod, nodes); node != nil {
+				entries[idx].TargetNode = node.Name
+			}
+		}
+	}
+	return plan.NewFile(entries)
+}
+
+// applyPlan evicts the pods of the plan entries which are still valid
+func (d *descheduler) applyPlan(ctx context.Context, file *plan.File) []plan.EntryResult {
+	d.podEvictor.SetClient(d.rs.Client)
+	d.podEvictor.SetPodDisruptionBudgetLister(d.sharedInformerFactory.Policy().V1().PodDisruptionBudgets().Lister())
+	d.podEvictor.SetPodIndexer(d.sharedInformerFactory.Core().V1().Pods().Informer().GetIndexer())
+	d.podEvictor.ResetCounters()
+	if err := d.podEvictor.LoadRateLimitState(ctx); err != nil {
+		klog.ErrorS(err, "unable to load the eviction rate limit state, using the state in memory")
+	}
+
+	if d.profileRunners == nil {
+		d.profileRunners = make([]*profileRunner, len(d.deschedulerPolicy.Profiles))
+	}
+	profileRunners := map[string]*profileRunner{}
+	for idx, profile := range d.deschedulerPolicy.Profiles {
+		if d.profileRunners[idx] == nil {
+			profileR, err := d.buildProfileRunner(ctx, profile, d.handleOptions(d.rs.Client)...)
+			if err != nil {
+				klog.ErrorS(err, "unable to create a profile", "profile", profile.Name)
+				continue
+			}
+			d.profileRunners[idx] = profileR
+		}
+		profileR := d.profileRunners[idx]
+		if err := profileR.beforeCycle(ctx); err != nil {
+			klog.ErrorS(err, "skipping a profile while applying the plan", "profile", profileR.name)
+			continue
+		}
+		profileRunners[profile.Name] = profileR
+	}
+
+	podLister := d.sharedInformerFactory.Core().V1().Pods().Lister()
+	results := make([]plan.EntryResult, 0, len(file.Entries))
+	for _, entry := range file.Entries {
+		err := applyPlanEntry(ctx, profileRunners[entry.Profile], podLister, entry)
+		if err != nil {
+			klog.V(1).InfoS("Planned eviction not applied", "pod", klog.KRef(entry.Namespace, entry.Name), "profile", entry.Profile, "plugin", entry.Plugin, "err", err)
+		}
+		results = append(results, plan.EntryResult{Entry: entry, Err: err})
+	}
+
+	for _, profileR := range profileRunners {
+		profileR.afterCycle(ctx)
+	}
+	if err := d.podEvictor.SaveRateLimitState(ctx); err != nil {
+		klog.ErrorS(err, "unable to save the eviction rate limit state")
+	}
+	klog.V(1).InfoS("Number of evictions/requests", "totalEvicted", d.podEvictor.TotalEvicted(), "evictionRequests", d.podEvictor.TotalEvictionRequests())
+	return results
+}
+
+// applyPlanEntry evicts the pod of the entry unless the pod changed since the plan was computed
+// or the profile does not consider the pod evictable anymore
+func applyPlanEntry(ctx context.Context, profileR *profileRunner, podLister corev1listers.PodLister, entry plan.Entry) error {
+	if profileR == nil {
+		return fmt.Errorf("profile %s is not in the policy", entry.Profile)
+	}
+	pod, err := podLister.Pods(entry.Namespace).Get(entry.Name)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("pod no longer exists")
+	}
+	if err != nil {
+		return err
+	}
+	if pod.UID != entry.UID {
+		return fmt.Errorf("pod was recreated, its UID is %s instead of %s", pod.UID, entry.UID)
+	}
+	if pod.Spec.NodeName != entry.Node {
+		return fmt.Errorf("pod runs on node %s instead of %s", pod.Spec.NodeName, entry.Node)
+	}
+	if pod.DeletionTimestamp != nil {
+		return fmt.Errorf("pod is being deleted")
+	}
+	if !profileR.evictor.Filter(pod) || !profileR.evictor.PreEvictionFilter(pod) {
+		return fmt.Errorf("pod is no longer evictable by profile %s", entry.Profile)
+	}
+	return profileR.evictor.Evict(ctx, pod, evictions.EvictOptions{StrategyName: entry.Plugin, Reason: entry.Reason})
+}
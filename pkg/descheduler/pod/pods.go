@@ -17,6 +17,7 @@ limitations under the License.
 package pod
 
 import (
+	"errors"
 	"sort"
 
 	v1 "k8s.io/api/core/v1"
@@ -30,11 +31,16 @@ import (
 
 const (
 	nodeNameKeyIndex = "spec.nodeName"
+	// OwnerRefUIDsIndex indexes pods by the UIDs of their owners
+	OwnerRefUIDsIndex = "metadata.ownerReferences"
 )
 
 // FilterFunc is a filter for a pod.
 type FilterFunc func(*v1.Pod) bool
 
+// LessFunc reports whether pod1 is to be ordered before pod2.
+type LessFunc func(pod1, pod2 *v1.Pod) bool
+
 // GetPodsAssignedToNodeFunc is a function which accept a node name and a pod filter function
 // as input and returns the pods that assigned to the node.
 type GetPodsAssignedToNodeFunc func(string, FilterFunc) ([]*v1.Pod, error)
@@ -230,6 +236,34 @@ func OwnerRefUIDs(pod *v1.Pod) []string {
 	return ownerRefUIDs
 }
 
+// GetPodIndexerByOwnerRefs returns the indexer of the pod informer with the pods
+// indexed by the UIDs of their owners under indexName. The index is added when missing.
+func GetPodIndexerByOwnerRefs(indexName string, podInformer cache.SharedIndexInformer) (cache.Indexer, error) {
+	indexer := podInformer.GetIndexer()
+
+	// do not reinitialize the indexer, if it's been defined already
+	for name := range indexer.GetIndexers() {
+		if name == indexName {
+			return indexer, nil
+		}
+	}
+
+	if err := podInformer.AddIndexers(cache.Indexers{
+		indexName: func(obj interface{}) ([]string, error) {
+			pod, ok := obj.(*v1.Pod)
+			if !ok {
+				return []string{}, errors.New("unexpected object")
+			}
+
+			return OwnerRefUIDs(pod), nil
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return indexer, nil
+}
+
 func IsBestEffortPod(pod *v1.Pod) bool {
 	return utils.GetPodQOS(pod) == v1.PodQOSBestEffort
 }
@@ -242,11 +276,21 @@ func IsGuaranteedPod(pod *v1.Pod) bool {
 	return utils.GetPodQOS(pod) == v1.PodQOSGuaranteed
 }
 
+// IsPodReady returns true when the pod has the Ready condition set to true
+func IsPodReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
 // SortPodsBasedOnPriorityLowToHigh sorts pods based on their priorities from low to high.
 // If pods have same priorities, they will be sorted by QoS in the following order:
-// BestEffort, Burstable, Guaranteed
+// BestEffort, Burstable, Guaranteed. The sort is stable.
 func SortPodsBasedOnPriorityLowToHigh(pods []*v1.Pod) {
-	sort.Slice(pods, func(i, j int) bool {
+	sort.SliceStable(pods, func(i, j int) bool {
 		if pods[i].Spec.Priority == nil && pods[j].Spec.Priority != nil {
 			return true
 		}
@@ -254,7 +298,7 @@ func SortPodsBasedOnPriorityLowToHigh(pods []*v1.Pod) {
 			return false
 		}
 		if (pods[j].Spec.Priority == nil && pods[i].Spec.Priority == nil) || (*pods[i].Spec.Priority == *pods[j].Spec.Priority) {
-			if IsBestEffortPod(pods[i]) {
+			if IsBestEffortPod(pods[i]) && !IsBestEffortPod(pods[j]) {
 				return true
 			}
 			if IsBurstablePod(pods[i]) && IsGuaranteedPod(pods[j]) {
@@ -266,13 +310,34 @@ func SortPodsBasedOnPriorityLowToHigh(pods []*v1.Pod) {
 	})
 }
 
-// SortPodsBasedOnAge sorts Pods from oldest to most recent in place
+// SortPodsBasedOnAge sorts Pods from oldest to most recent in place. The sort is stable.
 func SortPodsBasedOnAge(pods []*v1.Pod) {
-	sort.Slice(pods, func(i, j int) bool {
+	sort.SliceStable(pods, func(i, j int) bool {
 		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
 	})
 }
 
+// SortPodsBasedOnLessFuncs sorts pods in place by a chain of LessFuncs.
+// Each consecutive LessFunc is consulted only when all the previous ones
+// consider both pods equal. The sort is stable so any order the pods already
+// have is kept for pods that are equal with respect to all the LessFuncs.
+func SortPodsBasedOnLessFuncs(pods []*v1.Pod, lessFuncs ...LessFunc) {
+	if len(lessFuncs) == 0 {
+		return
+	}
+	sort.SliceStable(pods, func(i, j int) bool {
+		for _, less := range lessFuncs {
+			if less(pods[i], pods[j]) {
+				return true
+			}
+			if less(pods[j], pods[i]) {
+				return false
+			}
+		}
+		return false
+	})
+}
+
 func GroupByNodeName(pods []*v1.Pod) map[string][]*v1.Pod {
 	m := make(map[string][]*v1.Pod)
 	for i := 0; i < len(pods); i++ {
@@ -23,13 +23,18 @@ import (
 	"os"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+	"sigs.k8s.io/descheduler/pkg/descheduler/schedule"
 	"sigs.k8s.io/descheduler/pkg/descheduler/scheme"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
@@ -66,6 +71,79 @@ func decode(policyConfigFile string, policy []byte, client clientset.Interface,
 	return setDefaults(*internalPolicy, registry, client)
 }
 
+// PolicyError is an error of a policy found at the field with the path
+type PolicyError struct {
+	// Field is the path of the field in the v1alpha2 policy, e.g. profiles[0].pluginConfig[1].args
+	Field string
+	Err   error
+}
+
+func (e *PolicyError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PolicyError) Unwrap() error {
+	return e.Err
+}
+
+// ValidatePolicyConfig decodes the policy config file strictly and validates the policy and the
+// arguments of its plugins without connecting to a cluster. Every error found is returned,
+// the unknown and duplicated fields included.
+func ValidatePolicyConfig(policyConfigFile string, registry pluginregistry.Registry) []error {
+	policy, err := os.ReadFile(policyConfigFile)
+	if err != nil {
+		return []error{fmt.Errorf("failed to read policy config file %q: %+v", policyConfigFile, err)}
+	}
+
+	var errs []error
+	internalPolicy := &api.DeschedulerPolicy{}
+	decoder := scheme.StrictCodecs.UniversalDecoder(v1alpha2.SchemeGroupVersion, api.SchemeGroupVersion)
+	if err := runtime.DecodeInto(decoder, policy, internalPolicy); err != nil {
+		// the policy is decoded in spite of the unknown or duplicated fields
+		strictErr, ok := runtime.AsStrictDecodingError(err)
+		if !ok {
+			return []error{fmt.Errorf("failed decoding descheduler's policy config %q: %v", policyConfigFile, err)}
+		}
+		errs = append(errs, strictErr.Errors()...)
+	}
+	if err := validateDeschedulerConfiguration(*internalPolicy, registry); err != nil {
+		errs = append(errs, utilerrors.Flatten(err.(utilerrors.Aggregate)).Errors()...)
+	}
+	return errs
+}
+
+// RenderPolicyConfig loads the policy config file and returns the policy with all the defaults
+// set, the arguments of the plugins included. Unlike LoadPolicyConfig no cluster is needed,
+// the priority threshold given by a priority class name is not resolved into its value.
+func RenderPolicyConfig(policyConfigFile string, registry pluginregistry.Registry) (*v1alpha2.DeschedulerPolicy, error) {
+	internalPolicy, err := LoadPolicyConfig(policyConfigFile, nil, registry)
+	if err != nil {
+		return nil, err
+	}
+	if internalPolicy == nil {
+		return nil, fmt.Errorf("policy config file not specified")
+	}
+	// the plugins share the type of their arguments across the versions, the arguments
+	// are put aside during the conversion as their types are not registered as kinds
+	withoutArgs := internalPolicy.DeepCopy()
+	for i := range withoutArgs.Profiles {
+		for j := range withoutArgs.Profiles[i].PluginConfigs {
+			withoutArgs.Profiles[i].PluginConfigs[j].Args = nil
+		}
+	}
+	policy := &v1alpha2.DeschedulerPolicy{}
+	if err := scheme.Scheme.Convert(withoutArgs, policy, nil); err != nil {
+		return nil, fmt.Errorf("unable to convert the policy to %s: %v", v1alpha2.SchemeGroupVersion, err)
+	}
+	for i := range policy.Profiles {
+		for j := range policy.Profiles[i].PluginConfigs {
+			policy.Profiles[i].PluginConfigs[j].Args = runtime.RawExtension{Object: internalPolicy.Profiles[i].PluginConfigs[j].Args}
+		}
+	}
+	policy.SetGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("DeschedulerPolicy"))
+	return policy, nil
+}
+
 func setDefaults(in api.DeschedulerPolicy, registry pluginregistry.Registry, client clientset.Interface) (*api.DeschedulerPolicy, error) {
 	var err error
 	for idx, profile := range in.Profiles {
@@ -127,7 +205,12 @@ func setDefaultEvictor(profile api.DeschedulerProfile, client clientset.Interfac
 		idx = 0
 	}
 
-	thresholdPriority, err := utils.GetPriorityValueFromPriorityThreshold(context.TODO(), client, defaultevictorPluginConfig.Args.(*defaultevictor.DefaultEvictorArgs).PriorityThreshold)
+	priorityThreshold := defaultevictorPluginConfig.Args.(*defaultevictor.DefaultEvictorArgs).PriorityThreshold
+	// the priority class can be resolved only when the policy is loaded for a cluster
+	if client == nil && priorityThreshold != nil && priorityThreshold.Value == nil {
+		return profile, nil
+	}
+	thresholdPriority, err := utils.GetPriorityValueFromPriorityThreshold(context.TODO(), client, priorityThreshold)
 	if err != nil {
 		klog.Error(err, "Failed to get threshold priority from args")
 		return profile, err
@@ -139,10 +222,25 @@ func setDefaultEvictor(profile api.DeschedulerProfile, client clientset.Interfac
 
 func validateDeschedulerConfiguration(in api.DeschedulerPolicy, registry pluginregistry.Registry) error {
 	var errorsInPolicy []error
-	for _, profile := range in.Profiles {
-		for _, pluginConfig := range profile.PluginConfigs {
+	invalid := func(path *field.Path, format string, args ...interface{}) {
+		errorsInPolicy = append(errorsInPolicy, &PolicyError{Field: path.String(), Err: fmt.Errorf(format, args...)})
+	}
+	for profileIdx, profile := range in.Profiles {
+		profilePath := field.NewPath("profiles").Index(profileIdx)
+		if profile.NodeSelector != nil {
+			if _, err := labels.Parse(*profile.NodeSelector); err != nil {
+				invalid(profilePath.Child("nodeSelector"), "in profile %s: unable to parse nodeSelector: %v", profile.Name, err)
+			}
+		}
+		if profile.Schedule != nil {
+			if _, err := schedule.New(profile.Schedule); err != nil {
+				invalid(profilePath.Child("schedule"), "in profile %s: invalid schedule: %v", profile.Name, err)
+			}
+		}
+		for pluginConfigIdx, pluginConfig := range profile.PluginConfigs {
+			pluginConfigPath := profilePath.Child("pluginConfig").Index(pluginConfigIdx)
 			if _, ok := registry[pluginConfig.Name]; !ok {
-				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: plugin %s in pluginConfig not registered", profile.Name, pluginConfig.Name))
+				invalid(pluginConfigPath.Child("name"), "in profile %s: plugin %s in pluginConfig not registered", profile.Name, pluginConfig.Name)
 				continue
 			}
 
@@ -151,42 +249,101 @@ func validateDeschedulerConfiguration(in api.DeschedulerPolicy, registry pluginr
 				continue
 			}
 			if err := pluginUtilities.PluginArgValidator(pluginConfig.Args); err != nil {
-				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: %s", profile.Name, err.Error()))
+				// every error of the plugin arguments is reported on its own
+				for _, err := range utilerrors.Flatten(utilerrors.NewAggregate([]error{err})).Errors() {
+					invalid(pluginConfigPath.Child("args"), "in profile %s: %s", profile.Name, err.Error())
+				}
+			}
+		}
+	}
+	if in.Concurrency != nil && *in.Concurrency == 0 {
+		invalid(field.NewPath("concurrency"), "concurrency must be greater than 0")
+	}
+	if in.EvictionRateLimits != nil {
+		rateLimitsPath := field.NewPath("evictionRateLimits")
+		for idx, limit := range in.EvictionRateLimits.Limits {
+			limitPath := rateLimitsPath.Child("limits").Index(idx)
+			if limit.Scope != "" && limit.Scope != api.ClusterRateLimitScope && limit.Scope != api.NamespaceRateLimitScope {
+				invalid(limitPath.Child("scope"), "eviction rate limit scope %q is not one of %q and %q", limit.Scope, api.ClusterRateLimitScope, api.NamespaceRateLimitScope)
+			}
+			if limit.MaxEvictions == 0 {
+				invalid(limitPath.Child("maxEvictions"), "eviction rate limit maxEvictions must be greater than 0")
+			}
+			if limit.Window.Duration <= 0 {
+				invalid(limitPath.Child("window"), "eviction rate limit window must be greater than 0")
+			}
+		}
+		if cm := in.EvictionRateLimits.StateConfigMap; cm != nil && (cm.Namespace == "" || cm.Name == "") {
+			invalid(rateLimitsPath.Child("stateConfigMap"), "eviction rate limit stateConfigMap does not set both namespace and name")
+		}
+	}
+	if in.MaxUnavailablePerOwner != nil {
+		if maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(in.MaxUnavailablePerOwner, 100, true); err != nil {
+			invalid(field.NewPath("maxUnavailablePerOwner"), "maxUnavailablePerOwner is invalid: %v", err)
+		} else if maxUnavailable < 0 {
+			invalid(field.NewPath("maxUnavailablePerOwner"), "maxUnavailablePerOwner must not be negative")
+		}
+	}
+	if in.RollingEvictions != nil && in.RollingEvictions.Timeout != nil && in.RollingEvictions.Timeout.Duration <= 0 {
+		invalid(field.NewPath("rollingEvictions", "timeout"), "rolling evictions timeout must be greater than 0")
+	}
+	if in.EvictionPlanning != nil {
+		planningPath := field.NewPath("evictionPlanning")
+		profileNames := sets.New[string]()
+		for _, profile := range in.Profiles {
+			profileNames.Insert(profile.Name)
+		}
+		for _, name := range sets.List(sets.KeySet(in.EvictionPlanning.ProfileWeights)) {
+			if !profileNames.Has(name) {
+				invalid(planningPath.Child("profileWeights").Key(name), "eviction planning sets a weight of profile %s which does not exist", name)
+			}
+			if in.EvictionPlanning.ProfileWeights[name] == 0 {
+				invalid(planningPath.Child("profileWeights").Key(name), "eviction planning weight of profile %s must be greater than 0", name)
+			}
+		}
+		for _, namespace := range sets.List(sets.KeySet(in.EvictionPlanning.NamespaceWeights)) {
+			if in.EvictionPlanning.NamespaceWeights[namespace] == 0 {
+				invalid(planningPath.Child("namespaceWeights").Key(namespace), "eviction planning weight of namespace %s must be greater than 0", namespace)
 			}
 		}
 	}
 	providers := map[api.MetricsSource]api.MetricsProvider{}
-	for _, provider := range in.MetricsProviders {
+	providerPaths := map[api.MetricsSource]*field.Path{}
+	for idx, provider := range in.MetricsProviders {
+		providerPath := field.NewPath("metricsProviders").Index(idx)
 		if _, ok := providers[provider.Source]; ok {
-			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("metric provider %q is already configured, each source can be configured only once", provider.Source))
+			invalid(providerPath.Child("source"), "metric provider %q is already configured, each source can be configured only once", provider.Source)
 		} else {
 			providers[provider.Source] = provider
+			providerPaths[provider.Source] = providerPath
 		}
 	}
 	if _, exists := providers[api.KubernetesMetrics]; exists && in.MetricsCollector != nil && in.MetricsCollector.Enabled {
-		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("it is not allowed to combine metrics provider when metrics collector is enabled"))
+		invalid(field.NewPath("metricsCollector", "enabled"), "it is not allowed to combine metrics provider when metrics collector is enabled")
 	}
 	if prometheusConfig, exists := providers[api.PrometheusMetrics]; exists {
+		prometheusPath := providerPaths[api.PrometheusMetrics].Child("prometheus")
 		if prometheusConfig.Prometheus == nil {
-			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("prometheus configuration is required when prometheus source is enabled"))
+			invalid(prometheusPath, "prometheus configuration is required when prometheus source is enabled")
 		} else {
 			if prometheusConfig.Prometheus.URL == "" {
-				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("prometheus URL is required when prometheus is enabled"))
+				invalid(prometheusPath.Child("url"), "prometheus URL is required when prometheus is enabled")
 			} else {
 				u, err := url.Parse(prometheusConfig.Prometheus.URL)
 				if err != nil {
-					errorsInPolicy = append(errorsInPolicy, fmt.Errorf("error parsing prometheus URL: %v", err))
+					invalid(prometheusPath.Child("url"), "error parsing prometheus URL: %v", err)
 				} else if u.Scheme != "https" {
-					errorsInPolicy = append(errorsInPolicy, fmt.Errorf("prometheus URL's scheme is not https, got %q instead", u.Scheme))
+					invalid(prometheusPath.Child("url"), "prometheus URL's scheme is not https, got %q instead", u.Scheme)
 				}
 			}
 
 			if prometheusConfig.Prometheus.AuthToken != nil {
 				secretRef := prometheusConfig.Prometheus.AuthToken.SecretReference
+				secretRefPath := prometheusPath.Child("authToken", "secretReference")
 				if secretRef == nil {
-					errorsInPolicy = append(errorsInPolicy, fmt.Errorf("prometheus authToken secret is expected to be set when authToken field is"))
+					invalid(secretRefPath, "prometheus authToken secret is expected to be set when authToken field is")
 				} else if secretRef.Name == "" || secretRef.Namespace == "" {
-					errorsInPolicy = append(errorsInPolicy, fmt.Errorf("prometheus authToken secret reference does not set both namespace and name"))
+					invalid(secretRefPath, "prometheus authToken secret reference does not set both namespace and name")
 				}
 			}
 		}
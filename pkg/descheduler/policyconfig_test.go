@@ -19,10 +19,15 @@ package descheduler
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	utilptr "k8s.io/utils/ptr"
 	"sigs.k8s.io/descheduler/pkg/api"
@@ -273,6 +278,93 @@ func TestValidateDeschedulerConfiguration(t *testing.T) {
 			},
 			result: fmt.Errorf("prometheus URL's scheme is not https, got \"http\" instead"),
 		},
+		{
+			description: "invalid profile node selector error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name:         "Profile",
+						NodeSelector: utilptr.To("node-pool in (batch"),
+					},
+				},
+			},
+			result: fmt.Errorf("in profile Profile: unable to parse nodeSelector: unable to parse requirement: found '', expected: ',' or ')'"),
+		},
+		{
+			description: "invalid profile schedule error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name:     "Profile",
+						Schedule: &api.ProfileSchedule{Cron: "0 25 * * *"},
+					},
+				},
+			},
+			result: fmt.Errorf("in profile Profile: invalid schedule: invalid cron expression \"0 25 * * *\": hour value 25 out of range [0, 23]"),
+		},
+		{
+			description: "invalid eviction rate limit scope error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionRateLimits: &api.EvictionRateLimits{
+					Limits: []api.EvictionRateLimit{
+						{Scope: "Node", MaxEvictions: 1, Window: metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+			result: fmt.Errorf("eviction rate limit scope \"Node\" is not one of \"Cluster\" and \"Namespace\""),
+		},
+		{
+			description: "zero eviction rate limit window and missing state config map name error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionRateLimits: &api.EvictionRateLimits{
+					Limits: []api.EvictionRateLimit{
+						{MaxEvictions: 1},
+					},
+					StateConfigMap: &api.ConfigMapReference{Namespace: "kube-system"},
+				},
+			},
+			result: fmt.Errorf("[eviction rate limit window must be greater than 0, eviction rate limit stateConfigMap does not set both namespace and name]"),
+		},
+		{
+			description: "zero rolling evictions timeout error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				RollingEvictions: &api.RollingEvictions{
+					Timeout: &metav1.Duration{},
+				},
+			},
+			result: fmt.Errorf("rolling evictions timeout must be greater than 0"),
+		},
+		{
+			description: "invalid maxUnavailablePerOwner error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxUnavailablePerOwner: utilptr.To(intstr.FromString("ten")),
+			},
+			result: fmt.Errorf("maxUnavailablePerOwner is invalid: invalid value for IntOrString: invalid type: string is not a percentage"),
+		},
+		{
+			description: "negative maxUnavailablePerOwner error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxUnavailablePerOwner: utilptr.To(intstr.FromInt32(-1)),
+			},
+			result: fmt.Errorf("maxUnavailablePerOwner must not be negative"),
+		},
+		{
+			description: "invalid eviction planning weights error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionPlanning: &api.EvictionPlanning{
+					ProfileWeights:   map[string]uint{"unknown": 2},
+					NamespaceWeights: map[string]uint{"default": 0},
+				},
+			},
+			result: fmt.Errorf("[eviction planning sets a weight of profile unknown which does not exist, eviction planning weight of namespace default must be greater than 0]"),
+		},
+		{
+			description: "zero concurrency error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Concurrency: utilptr.To[uint](0),
+			},
+			result: fmt.Errorf("concurrency must be greater than 0"),
+		},
 		{
 			description: "prometheus authtoken with no secret reference error",
 			deschedulerPolicy: api.DeschedulerPolicy{
@@ -529,3 +621,126 @@ profiles:
 		})
 	}
 }
+
+func writePolicy(t *testing.T, policy string) string {
+	t.Helper()
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(policyFile, []byte(policy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return policyFile
+}
+
+func TestValidatePolicyConfig(t *testing.T) {
+	SetupPlugins()
+	testCases := []struct {
+		description string
+		policy      string
+		expected    []string
+	}{
+		{
+			description: "valid policy with a priority class name",
+			policy: `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: ProfileName
+    pluginConfig:
+    - name: "DefaultEvictor"
+      args:
+        priorityThreshold:
+          name: high-priority
+    - name: "RemoveFailedPods"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemoveFailedPods"
+`,
+		},
+		{
+			description: "every error reported with its field",
+			policy: `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+concurrency: 0
+profiles:
+  - name: ProfileName
+    nodeSelectr: "a=b"
+    pluginConfig:
+    - name: "RemoveFailedPods"
+      args:
+        namespaces:
+          include: ["a"]
+          exclude: ["b"]
+    - name: "Unknown"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemoveFailedPods"
+evictionRateLimits:
+  limits:
+  - scope: Node
+    maxEvictions: 1
+    window: 1m
+`,
+			expected: []string{
+				`: unknown field "profiles[0].nodeSelectr"`,
+				`profiles[0].pluginConfig[0].args: in profile ProfileName: only one of Include/Exclude namespaces can be set`,
+				`profiles[0].pluginConfig[1].name: in profile ProfileName: plugin Unknown in pluginConfig not registered`,
+				`concurrency: concurrency must be greater than 0`,
+				`evictionRateLimits.limits[0].scope: eviction rate limit scope "Node" is not one of "Cluster" and "Namespace"`,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var got []string
+			for _, err := range ValidatePolicyConfig(writePolicy(t, tc.policy), pluginregistry.PluginRegistry) {
+				var policyErr *PolicyError
+				if errors.As(err, &policyErr) {
+					got = append(got, policyErr.Field+": "+policyErr.Error())
+				} else {
+					got = append(got, ": "+err.Error())
+				}
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected errors (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderPolicyConfig(t *testing.T) {
+	SetupPlugins()
+	policy, err := RenderPolicyConfig(writePolicy(t, `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: ProfileName
+    pluginConfig:
+    - name: "DefaultEvictor"
+      args:
+        priorityThreshold:
+          name: high-priority
+    - name: "RemoveFailedPods"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemoveFailedPods"
+`), pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to render the policy: %v", err)
+	}
+	if policy.APIVersion != "descheduler/v1alpha2" || policy.Kind != "DeschedulerPolicy" {
+		t.Errorf("Unexpected type of the rendered policy: %v", policy.TypeMeta)
+	}
+	profile := policy.Profiles[0]
+	if diff := cmp.Diff([]string{defaultevictor.PluginName}, profile.Plugins.Filter.Enabled); diff != "" {
+		t.Errorf("Expected the default evictor enabled as a filter (-want +got):\n%s", diff)
+	}
+	evictorArgs := profile.PluginConfigs[0].Args.Object.(*defaultevictor.DefaultEvictorArgs)
+	if evictorArgs.PriorityThreshold.Name != "high-priority" || evictorArgs.PriorityThreshold.Value != nil {
+		t.Errorf("Expected the priority class name to be kept, got %+v", evictorArgs.PriorityThreshold)
+	}
+	failedPodsArgs := profile.PluginConfigs[1].Args.Object.(*removefailedpods.RemoveFailedPodsArgs)
+	if failedPodsArgs.MinPodLifetimeSeconds == nil || *failedPodsArgs.MinPodLifetimeSeconds != 3600 {
+		t.Errorf("Expected the default minPodLifetimeSeconds, got %v", failedPodsArgs.MinPodLifetimeSeconds)
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+	policyv1alpha1 "sigs.k8s.io/descheduler/pkg/apis/policy/v1alpha1"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// policyResource is the DeschedulerPolicy object the policy is read from instead of the policy file
+type policyResource struct {
+	name   string
+	client dynamic.ResourceInterface
+	// informerFactory watches the object, the changes are reconciled between the descheduling cycles
+	informerFactory dynamicinformer.DynamicSharedInformerFactory
+	lister          cache.GenericLister
+	// generation of the object last checked, zero until the first check
+	generation int64
+	// dryRun disables the updates of the status
+	dryRun bool
+}
+
+func newPolicyResource(client dynamic.Interface, name string, dryRun bool) *policyResource {
+	informerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, metav1.NamespaceAll, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+	})
+	return &policyResource{
+		name:            name,
+		client:          client.Resource(policyv1alpha1.SchemeGroupVersionResource),
+		informerFactory: informerFactory,
+		lister:          informerFactory.ForResource(policyv1alpha1.SchemeGroupVersionResource).Lister(),
+		dryRun:          dryRun,
+	}
+}
+
+// get returns the object watched
+func (p *policyResource) get() (*unstructured.Unstructured, error) {
+	object, err := p.lister.Get(p.name)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object of type %T", object)
+	}
+	return u, nil
+}
+
+// updateStatus changes the status of the object, retrying on conflicts
+func (p *policyResource) updateStatus(ctx context.Context, mutate func(status *policyv1alpha1.DeschedulerPolicyStatus)) error {
+	if p.dryRun {
+		klog.V(3).InfoS("Status of the policy not updated in the dry run mode", "policy", p.name)
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		u, err := p.client.Get(ctx, p.name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		policy := &policyv1alpha1.DeschedulerPolicy{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, policy); err != nil {
+			return err
+		}
+		mutate(&policy.Status)
+		status, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&policy.Status)
+		if err != nil {
+			return err
+		}
+		u.Object["status"] = status
+		_, err = p.client.UpdateStatus(ctx, u, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// reportValidation sets the Valid condition of the generation of the object checked
+func (p *policyResource) reportValidation(ctx context.Context, generation int64, validationErr error) {
+	err := p.updateStatus(ctx, func(status *policyv1alpha1.DeschedulerPolicyStatus) {
+		condition := metav1.Condition{
+			Type:               policyv1alpha1.ValidCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             policyv1alpha1.AcceptedReason,
+			Message:            "The policy is run by the descheduler",
+		}
+		if validationErr != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = policyv1alpha1.RejectedReason
+			condition.Message = validationErr.Error()
+		}
+		meta.SetStatusCondition(&status.Conditions, condition)
+		status.ObservedGeneration = generation
+	})
+	if err != nil {
+		klog.ErrorS(err, "unable to update the status of the policy", "policy", p.name)
+	}
+}
+
+// reportCycle sets the time and the evictions of the last descheduling cycle
+func (p *policyResource) reportCycle(ctx context.Context, reports []*frameworktypes.CycleReport, now time.Time) {
+	evicted, profiles := cycleStatus(reports)
+	err := p.updateStatus(ctx, func(status *policyv1alpha1.DeschedulerPolicyStatus) {
+		status.LastCycleTime = &metav1.Time{Time: now}
+		status.Evicted = evicted
+		status.Profiles = profiles
+	})
+	if err != nil {
+		klog.ErrorS(err, "unable to update the status of the policy", "policy", p.name)
+	}
+}
+
+func cycleStatus(reports []*frameworktypes.CycleReport) (int32, []policyv1alpha1.ProfileStatus) {
+	var evicted int32
+	var profiles []policyv1alpha1.ProfileStatus
+	for _, report := range reports {
+		profile := policyv1alpha1.ProfileStatus{Name: report.Profile}
+		for _, plugin := range report.Plugins {
+			if plugin.Status == nil {
+				continue
+			}
+			profile.Plugins = append(profile.Plugins, policyv1alpha1.PluginStatus{
+				Name:           plugin.Plugin,
+				ExtensionPoint: string(plugin.ExtensionPoint),
+				Evicted:        int32(len(plugin.Status.Evicted)),
+				Skipped:        int32(len(plugin.Status.Skipped)),
+			})
+			profile.Evicted += int32(len(plugin.Status.Evicted))
+		}
+		evicted += profile.Evicted
+		profiles = append(profiles, profile)
+	}
+	return evicted, profiles
+}
+
+// decodePolicyResource decodes the spec of the object the same way as the policy file
+func decodePolicyResource(u *unstructured.Unstructured, client clientset.Interface, registry pluginregistry.Registry) (*api.DeschedulerPolicy, error) {
+	spec, _, err := unstructured.NestedMap(u.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	spec["apiVersion"] = v1alpha2.SchemeGroupVersion.String()
+	spec["kind"] = "DeschedulerPolicy"
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return decode(u.GetName(), data, client, registry)
+}
+
+// loadPolicyResource loads the policy from the DeschedulerPolicy object when the descheduler starts
+// and reports whether the policy is valid
+func loadPolicyResource(ctx context.Context, client dynamic.Interface, kubeClient clientset.Interface, name string, dryRun bool) (*api.DeschedulerPolicy, error) {
+	p := newPolicyResource(client, name, dryRun)
+	u, err := p.client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the DeschedulerPolicy %q: %v", name, err)
+	}
+	deschedulerPolicy, err := decodePolicyResource(u, kubeClient, pluginregistry.PluginRegistry)
+	p.reportValidation(ctx, u.GetGeneration(), err)
+	if err != nil {
+		return nil, err
+	}
+	return deschedulerPolicy, nil
+}
+
+// reconcilePolicyResource checks the generation of the object watched and swaps the policy running
+// between the descheduling cycles, the same way as the policy file is reloaded. The outcome of every
+// generation is reported by the Valid condition of the object.
+func (d *descheduler) reconcilePolicyResource(ctx context.Context) {
+	p := d.policyResource
+	u, err := p.get()
+	if err != nil {
+		klog.ErrorS(err, "unable to get the policy, keeping the policy running", "policy", p.name)
+		return
+	}
+	if u.GetGeneration() == p.generation {
+		return
+	}
+	p.generation = u.GetGeneration()
+
+	// the first generation checked is usually the one loaded when the descheduler started
+	source := fmt.Sprintf("policy %v of generation %v", p.name, p.generation)
+	deschedulerPolicy, err := decodePolicyResource(u, d.rs.Client, pluginregistry.PluginRegistry)
+	if err != nil {
+		d.reportReload(source, nil, err)
+	} else if !apiequality.Semantic.DeepEqual(deschedulerPolicy, d.deschedulerPolicy) {
+		err = d.applyPolicy(ctx, deschedulerPolicy)
+		d.reportReload(source, deschedulerPolicy, err)
+	}
+	p.reportValidation(ctx, p.generation, err)
+}
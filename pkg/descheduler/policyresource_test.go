@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/yaml"
+
+	policyv1alpha1 "sigs.k8s.io/descheduler/pkg/apis/policy/v1alpha1"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+const policyResourceSpec = `maxNoOfPodsToEvictPerNode: 1
+profiles:
+  - name: Profile
+    pluginConfig:
+    - name: "RemovePodsViolatingNodeTaints"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsViolatingNodeTaints"
+`
+
+func policyObject(t *testing.T, generation int64, spec string) *unstructured.Unstructured {
+	t.Helper()
+	specObject := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(spec), &specObject); err != nil {
+		t.Fatal(err)
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": specObject}}
+	u.SetAPIVersion(policyv1alpha1.SchemeGroupVersion.String())
+	u.SetKind("DeschedulerPolicy")
+	u.SetName("cluster")
+	u.SetGeneration(generation)
+	return u
+}
+
+func newFakePolicyClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		policyv1alpha1.SchemeGroupVersionResource: "DeschedulerPolicyList",
+	}, objects...)
+}
+
+func policyStatus(t *testing.T, ctx context.Context, client *dynamicfake.FakeDynamicClient) policyv1alpha1.DeschedulerPolicyStatus {
+	t.Helper()
+	u, err := client.Resource(policyv1alpha1.SchemeGroupVersionResource).Get(ctx, "cluster", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unable to get the policy: %v", err)
+	}
+	policy := &policyv1alpha1.DeschedulerPolicy{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, policy); err != nil {
+		t.Fatalf("Unable to convert the policy: %v", err)
+	}
+	return policy.Status
+}
+
+func TestLoadPolicyResource(t *testing.T) {
+	initPluginRegistry()
+	ctx := context.Background()
+
+	tests := []struct {
+		description   string
+		spec          string
+		dryRun        bool
+		expectedValid metav1.ConditionStatus
+		expectedError string
+	}{
+		{
+			description:   "valid policy",
+			spec:          policyResourceSpec,
+			expectedValid: metav1.ConditionTrue,
+		},
+		{
+			description:   "invalid policy",
+			spec:          policyResourceSpec + "concurrency: 0\n",
+			expectedValid: metav1.ConditionFalse,
+			expectedError: "concurrency must be greater than 0",
+		},
+		{
+			description: "status not reported in the dry run mode",
+			spec:        policyResourceSpec,
+			dryRun:      true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			client := newFakePolicyClient(policyObject(t, 3, tc.spec))
+			deschedulerPolicy, err := loadPolicyResource(ctx, client, fakeclientset.NewSimpleClientset(), "cluster", tc.dryRun)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("Expected the %q error, got %v", tc.expectedError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unable to load the policy: %v", err)
+			} else if got := deschedulerPolicy.Profiles[0].Name; got != "Profile" {
+				t.Errorf("Expected the profile of the object, got %v", got)
+			}
+
+			status := policyStatus(t, ctx, client)
+			valid := meta.FindStatusCondition(status.Conditions, policyv1alpha1.ValidCondition)
+			if tc.dryRun {
+				if valid != nil {
+					t.Errorf("Expected no condition in the dry run mode, got %v", valid)
+				}
+				return
+			}
+			if valid == nil || valid.Status != tc.expectedValid || valid.ObservedGeneration != 3 || status.ObservedGeneration != 3 {
+				t.Errorf("Expected the Valid condition to be %v for the generation 3, got %v in %v", tc.expectedValid, valid, status)
+			}
+			if tc.expectedError != "" && (valid.Reason != policyv1alpha1.RejectedReason || valid.Message != tc.expectedError) {
+				t.Errorf("Expected the policy to be rejected with the error, got %v", valid)
+			}
+		})
+	}
+
+	if _, err := loadPolicyResource(ctx, newFakePolicyClient(), fakeclientset.NewSimpleClientset(), "cluster", false); err == nil {
+		t.Errorf("Expected an error when the object does not exist")
+	}
+}
+
+func TestReconcilePolicyResource(t *testing.T) {
+	initPluginRegistry()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newFakePolicyClient(policyObject(t, 1, policyResourceSpec))
+	deschedulerPolicy, err := decodePolicyResource(policyObject(t, 1, policyResourceSpec), fakeclientset.NewSimpleClientset(), pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to decode the policy: %v", err)
+	}
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	_, descheduler, _ := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, node1, node2)
+	defer descheduler.closeProfiles()
+	descheduler.eventRecorder = events.NewFakeRecorder(10)
+	descheduler.policyResource = newPolicyResource(client, "cluster", false)
+	descheduler.policyResource.informerFactory.Start(ctx.Done())
+	descheduler.policyResource.informerFactory.WaitForCacheSync(ctx.Done())
+
+	update := func(generation int64, spec string) {
+		t.Helper()
+		if _, err := client.Resource(policyv1alpha1.SchemeGroupVersionResource).Update(ctx, policyObject(t, generation, spec), metav1.UpdateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+			u, err := descheduler.policyResource.get()
+			return err == nil && u.GetGeneration() == generation, nil
+		}); err != nil {
+			t.Fatalf("The generation %v was not observed: %v", generation, err)
+		}
+		descheduler.reconcilePolicyResource(ctx)
+	}
+	expectValid := func(status metav1.ConditionStatus, generation int64, message string) {
+		t.Helper()
+		valid := meta.FindStatusCondition(policyStatus(t, ctx, client).Conditions, policyv1alpha1.ValidCondition)
+		if valid == nil || valid.Status != status || valid.ObservedGeneration != generation || valid.Message != message {
+			t.Errorf("Expected the Valid condition to be %v for the generation %v with the %q message, got %v", status, generation, message, valid)
+		}
+	}
+
+	// the generation loaded when the descheduler started is kept
+	descheduler.reconcilePolicyResource(ctx)
+	if descheduler.deschedulerPolicy != deschedulerPolicy {
+		t.Errorf("Expected the policy running to be kept when the object did not change")
+	}
+	expectValid(metav1.ConditionTrue, 1, "The policy is run by the descheduler")
+
+	update(2, `maxNoOfPodsToEvictPerNode: 5
+profiles:
+  - name: Reconciled
+    pluginConfig:
+    - name: "RemovePodsViolatingNodeTaints"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsViolatingNodeTaints"
+`)
+	if got := descheduler.deschedulerPolicy.Profiles[0].Name; got != "Reconciled" {
+		t.Errorf("Expected the reconciled profile, got %v", got)
+	}
+	expectValid(metav1.ConditionTrue, 2, "The policy is run by the descheduler")
+
+	running := descheduler.deschedulerPolicy
+	update(3, policyResourceSpec+"concurrency: 0\n")
+	if descheduler.deschedulerPolicy != running {
+		t.Errorf("Expected the policy running to be kept")
+	}
+	expectValid(metav1.ConditionFalse, 3, "concurrency must be greater than 0")
+	if got := policyStatus(t, ctx, client).ObservedGeneration; got != 3 {
+		t.Errorf("Expected the observed generation 3, got %v", got)
+	}
+
+	if err := descheduler.runDeschedulerLoop(ctx, []*v1.Node{node1, node2}); err != nil {
+		t.Fatalf("Unable to run a descheduling cycle: %v", err)
+	}
+	now := time.Now()
+	descheduler.policyResource.reportCycle(ctx, descheduler.reports, now)
+	status := policyStatus(t, ctx, client)
+	if status.LastCycleTime == nil || !status.LastCycleTime.Time.Equal(now.Truncate(time.Second)) {
+		t.Errorf("Expected the last cycle time %v, got %v", now, status.LastCycleTime)
+	}
+	expectedProfiles := []policyv1alpha1.ProfileStatus{{
+		Name:    "Reconciled",
+		Plugins: []policyv1alpha1.PluginStatus{{Name: "RemovePodsViolatingNodeTaints", ExtensionPoint: "Deschedule"}},
+	}}
+	if diff := cmp.Diff(expectedProfiles, status.Profiles); diff != "" {
+		t.Errorf("Unexpected profiles (-want +got):\n%s", diff)
+	}
+	expectValid(metav1.ConditionFalse, 3, "concurrency must be greater than 0")
+}
+
+func TestCycleStatus(t *testing.T) {
+	pod := func(name string) frameworktypes.PodResult {
+		return frameworktypes.PodResult{Namespace: "default", Name: name}
+	}
+	reports := []*frameworktypes.CycleReport{
+		{
+			Profile: "ProfileA",
+			Plugins: []frameworktypes.PluginReport{
+				{Plugin: "RemoveDuplicates", ExtensionPoint: frameworktypes.BalanceExtensionPoint, Status: &frameworktypes.Status{Evicted: []frameworktypes.PodResult{pod("p1"), pod("p2")}, Skipped: []frameworktypes.PodResult{pod("p3")}}},
+				{Plugin: "RemovePodsViolatingNodeTaints", ExtensionPoint: frameworktypes.DescheduleExtensionPoint, Status: &frameworktypes.Status{Evicted: []frameworktypes.PodResult{pod("p4")}}},
+			},
+		},
+		{Profile: "ProfileB"},
+	}
+	evicted, profiles := cycleStatus(reports)
+	if evicted != 3 {
+		t.Errorf("Expected 3 pods evicted, got %v", evicted)
+	}
+	expectedProfiles := []policyv1alpha1.ProfileStatus{
+		{
+			Name:    "ProfileA",
+			Evicted: 3,
+			Plugins: []policyv1alpha1.PluginStatus{
+				{Name: "RemoveDuplicates", ExtensionPoint: "Balance", Evicted: 2, Skipped: 1},
+				{Name: "RemovePodsViolatingNodeTaints", ExtensionPoint: "Deschedule", Evicted: 1},
+			},
+		},
+		{Name: "ProfileB"},
+	}
+	if diff := cmp.Diff(expectedProfiles, profiles); diff != "" {
+		t.Errorf("Unexpected profiles (-want +got):\n%s", diff)
+	}
+}
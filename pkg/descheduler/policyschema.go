@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+	policyv1alpha1 "sigs.k8s.io/descheduler/pkg/apis/policy/v1alpha1"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+const (
+	jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+	// PolicySchemaName is the name of the policy schema in the components of the OpenAPI document
+	PolicySchemaName = "io.k8s.sigs.descheduler.v1alpha2.DeschedulerPolicy"
+)
+
+var (
+	durationType     = reflect.TypeOf(metav1.Duration{})
+	timeType         = reflect.TypeOf(metav1.Time{})
+	intOrStringType  = reflect.TypeOf(intstr.IntOrString{})
+	quantityType     = reflect.TypeOf(resource.Quantity{})
+	rawExtensionType = reflect.TypeOf(runtime.RawExtension{})
+	pluginConfigType = reflect.TypeOf(v1alpha2.PluginConfig{})
+)
+
+// PolicySchema returns the JSON Schema of the v1alpha2 policy. The args of a plugin config
+// are described by a oneOf keyed by the name of the plugin, built from the arg types of all
+// the plugins in the registry, so the plugins registered by a custom build are included.
+func PolicySchema(registry pluginregistry.Registry) *spec.Schema {
+	schema := policySchema(registry)
+	schema.Schema = jsonSchemaDraft
+	return schema
+}
+
+// PolicyOpenAPI returns an OpenAPI v3 document with the schema of the v1alpha2 policy
+// in its components
+func PolicyOpenAPI(registry pluginregistry.Registry) *spec3.OpenAPI {
+	return &spec3.OpenAPI{
+		Version: "3.0.0",
+		Info: &spec.Info{
+			InfoProps: spec.InfoProps{
+				Title:   "Descheduler policy",
+				Version: v1alpha2.SchemeGroupVersion.Version,
+			},
+		},
+		Paths: &spec3.Paths{},
+		Components: &spec3.Components{
+			Schemas: map[string]*spec.Schema{
+				PolicySchemaName: policySchema(registry),
+			},
+		},
+	}
+}
+
+func policySchema(registry pluginregistry.Registry) *spec.Schema {
+	g := &schemaGenerator{registry: registry, visiting: map[reflect.Type]bool{}}
+	schema := g.schemaFor(reflect.TypeOf(v1alpha2.DeschedulerPolicy{}))
+	schema.Title = "DeschedulerPolicy"
+	schema.Properties["apiVersion"] = *spec.StringProperty().WithEnum(v1alpha2.SchemeGroupVersion.String())
+	schema.Properties["kind"] = *spec.StringProperty().WithEnum("DeschedulerPolicy")
+	schema.Required = []string{"apiVersion", "kind"}
+	return &schema
+}
+
+// PolicyCustomResourceDefinition returns the definition of the DeschedulerPolicy custom resource.
+// The schema of the spec is structural, the args of the plugins are kept as they are. The
+// descheduler validates the policy and reports the outcome in the Valid condition of the object.
+func PolicyCustomResourceDefinition(registry pluginregistry.Registry) (*apiextensionsv1.CustomResourceDefinition, error) {
+	g := &schemaGenerator{registry: registry, structural: true, visiting: map[reflect.Type]bool{}}
+	specSchema := g.schemaFor(reflect.TypeOf(v1alpha2.DeschedulerPolicy{}))
+	delete(specSchema.Properties, "apiVersion")
+	delete(specSchema.Properties, "kind")
+	specSchema.Description = "Policy in the format of the " + v1alpha2.SchemeGroupVersion.String() + " policy file, without apiVersion and kind"
+	statusSchema := g.schemaFor(reflect.TypeOf(policyv1alpha1.DeschedulerPolicyStatus{}))
+	statusSchema.Description = "Status reported by the descheduler running the policy"
+
+	schema := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Description: "DeschedulerPolicy is a descheduler policy managed as a cluster scoped object",
+			Type:        spec.StringOrArray{"object"},
+			Required:    []string{"spec"},
+			Properties: map[string]spec.Schema{
+				"apiVersion": *spec.StringProperty(),
+				"kind":       *spec.StringProperty(),
+				"metadata":   {SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"object"}}},
+				"spec":       specSchema,
+				"status":     statusSchema,
+			},
+		},
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	openAPIV3Schema := &apiextensionsv1.JSONSchemaProps{}
+	if err := json.Unmarshal(data, openAPIV3Schema); err != nil {
+		return nil, err
+	}
+
+	validCondition := fmt.Sprintf(`.status.conditions[?(@.type=="%s")]`, policyv1alpha1.ValidCondition)
+	return &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyv1alpha1.SchemeGroupVersionResource.GroupResource().String(),
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: policyv1alpha1.GroupName,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   policyv1alpha1.SchemeGroupVersionResource.Resource,
+				Singular: "deschedulerpolicy",
+				Kind:     "DeschedulerPolicy",
+				ListKind: "DeschedulerPolicyList",
+			},
+			Scope: apiextensionsv1.ClusterScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name:    policyv1alpha1.GroupVersion,
+				Served:  true,
+				Storage: true,
+				Schema:  &apiextensionsv1.CustomResourceValidation{OpenAPIV3Schema: openAPIV3Schema},
+				Subresources: &apiextensionsv1.CustomResourceSubresources{
+					Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+				},
+				AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+					{Name: "Valid", Type: "string", JSONPath: validCondition + ".status"},
+					{Name: "Reason", Type: "string", JSONPath: validCondition + ".reason", Priority: 1},
+					{Name: "Observed Generation", Type: "integer", JSONPath: ".status.observedGeneration", Priority: 1},
+					{Name: "Evicted", Type: "integer", JSONPath: ".status.evicted", Description: "Pods evicted in the last descheduling cycle"},
+					{Name: "Last Cycle", Type: "date", JSONPath: ".status.lastCycleTime"},
+					{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+				},
+			}},
+		},
+	}, nil
+}
+
+type schemaGenerator struct {
+	registry pluginregistry.Registry
+	// structural leaves out what a structural schema of a custom resource does not allow,
+	// i.e. additionalProperties next to properties and the alternatives of the plugin args
+	structural bool
+	// visiting holds the structs being walked so recursive types end the walk
+	visiting map[reflect.Type]bool
+}
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) spec.Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case durationType:
+		return *spec.StringProperty().WithDescription("Duration, e.g. 30s, 5m or 1h30m")
+	case timeType:
+		return *spec.DateTimeProperty()
+	case intOrStringType, quantityType:
+		return g.intOrStringSchema()
+	case rawExtensionType:
+		return freeFormSchema()
+	case pluginConfigType:
+		return g.pluginConfigSchema()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return *spec.BoolProperty()
+	case reflect.String:
+		return *spec.StringProperty()
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return *spec.Int32Property()
+	case reflect.Int, reflect.Int64:
+		return *spec.Int64Property()
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return *spec.Int32Property().WithMinimum(0, false)
+	case reflect.Uint, reflect.Uint64:
+		return *spec.Int64Property().WithMinimum(0, false)
+	case reflect.Float32, reflect.Float64:
+		return *spec.Float64Property()
+	case reflect.Slice, reflect.Array:
+		return *spec.ArrayProperty(ptrTo(g.schemaFor(t.Elem())))
+	case reflect.Map:
+		return *spec.MapProperty(ptrTo(g.schemaFor(t.Elem())))
+	case reflect.Struct:
+		return g.structSchema(t)
+	}
+	return freeFormSchema()
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) spec.Schema {
+	if g.visiting[t] {
+		return freeFormSchema()
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	schema := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type:       spec.StringOrArray{"object"},
+			Properties: map[string]spec.Schema{},
+		},
+	}
+	if !g.structural {
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: false}
+	}
+	g.addFields(&schema, t)
+	return schema
+}
+
+// addFields adds the fields of the struct the way encoding/json serializes them,
+// the inlined and embedded structs add their fields to the parent
+func (g *schemaGenerator) addFields(schema *spec.Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && (strings.Contains(opts, "inline") || field.Anonymous && name == "") {
+			g.addFields(schema, fieldType)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = g.schemaFor(field.Type)
+	}
+}
+
+// pluginConfigSchema describes a plugin config with one alternative per registered plugin,
+// the name of the plugin selects the schema of the args
+func (g *schemaGenerator) pluginConfigSchema() spec.Schema {
+	schema := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type:     spec.StringOrArray{"object"},
+			Required: []string{"name"},
+			Properties: map[string]spec.Schema{
+				"name": *spec.StringProperty(),
+				"args": freeFormSchema(),
+			},
+		},
+	}
+	if g.structural {
+		return schema
+	}
+	schema.AdditionalProperties = &spec.SchemaOrBool{Allows: false}
+
+	names := make([]string, 0, len(g.registry))
+	for name := range g.registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		args := freeFormSchema()
+		if instance := g.registry[name].PluginArgInstance; instance != nil {
+			args = g.schemaFor(reflect.TypeOf(instance))
+		}
+		schema.OneOf = append(schema.OneOf, spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Properties: map[string]spec.Schema{
+					"name": *spec.StringProperty().WithEnum(name),
+					"args": args,
+				},
+			},
+		})
+	}
+	return schema
+}
+
+func (g *schemaGenerator) intOrStringSchema() spec.Schema {
+	integer := *spec.Int64Property()
+	if g.structural {
+		// a structural schema allows only the types in the alternatives of an int or a string
+		integer.Format = ""
+	}
+	schema := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AnyOf: []spec.Schema{integer, *spec.StringProperty()},
+		},
+	}
+	schema.AddExtension("x-kubernetes-int-or-string", true)
+	return schema
+}
+
+func freeFormSchema() spec.Schema {
+	schema := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type: spec.StringOrArray{"object"},
+		},
+	}
+	schema.AddExtension("x-kubernetes-preserve-unknown-fields", true)
+	return schema
+}
+
+func ptrTo(schema spec.Schema) *spec.Schema {
+	return &schema
+}
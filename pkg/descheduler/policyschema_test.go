@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+)
+
+type outOfTreeArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Threshold *intstr.IntOrString `json:"threshold,omitempty"`
+	Window    metav1.Duration     `json:"window"`
+}
+
+func (in *outOfTreeArgs) DeepCopyObject() runtime.Object {
+	out := *in
+	return &out
+}
+
+func TestPolicySchema(t *testing.T) {
+	registry := pluginregistry.NewRegistry()
+	pluginregistry.Register(defaultevictor.PluginName, defaultevictor.New, &defaultevictor.DefaultEvictor{}, &defaultevictor.DefaultEvictorArgs{}, defaultevictor.ValidateDefaultEvictorArgs, defaultevictor.SetDefaults_DefaultEvictorArgs, registry)
+	pluginregistry.Register("OutOfTree", nil, nil, &outOfTreeArgs{}, nil, nil, registry)
+
+	schema := PolicySchema(registry)
+	if schema.Schema != jsonSchemaDraft {
+		t.Errorf("Expected the %q schema, got %q", jsonSchemaDraft, schema.Schema)
+	}
+	pluginConfig := schema.Properties["profiles"].Items.Schema.Properties["pluginConfig"].Items.Schema
+	if len(pluginConfig.OneOf) != 2 {
+		t.Fatalf("Expected an alternative for every plugin, got %d", len(pluginConfig.OneOf))
+	}
+	for idx, name := range []string{"DefaultEvictor", "OutOfTree"} {
+		if got := pluginConfig.OneOf[idx].Properties["name"].Enum; len(got) != 1 || got[0] != name {
+			t.Errorf("Expected alternative %d to be keyed by %q, got %v", idx, name, got)
+		}
+	}
+
+	evictorArgs := pluginConfig.OneOf[0].Properties["args"]
+	for _, property := range []string{"apiVersion", "kind", "evictLocalStoragePods", "labelSelector", "priorityThreshold", "minPodAge"} {
+		if _, ok := evictorArgs.Properties[property]; !ok {
+			t.Errorf("Expected the DefaultEvictor args to have the %q property", property)
+		}
+	}
+	if got := evictorArgs.Properties["minPodAge"].Type; !got.Contains("string") {
+		t.Errorf("Expected a duration to be a string, got %v", got)
+	}
+
+	outOfTree := pluginConfig.OneOf[1].Properties["args"]
+	if _, ok := outOfTree.Properties["threshold"].Extensions.GetBool("x-kubernetes-int-or-string"); !ok {
+		t.Errorf("Expected the threshold to be an int or a string, got %v", outOfTree.Properties["threshold"])
+	}
+	if outOfTree.AdditionalProperties == nil || outOfTree.AdditionalProperties.Allows {
+		t.Errorf("Expected the args to reject unknown fields")
+	}
+}
+
+func TestPolicyOpenAPI(t *testing.T) {
+	registry := pluginregistry.NewRegistry()
+	pluginregistry.Register(defaultevictor.PluginName, defaultevictor.New, &defaultevictor.DefaultEvictor{}, &defaultevictor.DefaultEvictorArgs{}, defaultevictor.ValidateDefaultEvictorArgs, defaultevictor.SetDefaults_DefaultEvictorArgs, registry)
+
+	document := PolicyOpenAPI(registry)
+	schema, ok := document.Components.Schemas[PolicySchemaName]
+	if !ok {
+		t.Fatalf("Expected the %s schema in the components", PolicySchemaName)
+	}
+	if schema.Schema != "" {
+		t.Errorf("Expected no JSON Schema draft in an OpenAPI document, got %q", schema.Schema)
+	}
+	if _, ok := schema.Properties["profiles"]; !ok {
+		t.Errorf("Expected the profiles property")
+	}
+}
+
+// TestPolicySchemaExamples checks the example policies use only the fields described by the schema
+func TestPolicySchemaExamples(t *testing.T) {
+	SetupPlugins()
+	schema := PolicySchema(pluginregistry.PluginRegistry)
+
+	files, err := filepath.Glob("../../examples/*.y*ml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("Expected example policies")
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var policy interface{}
+			if err := yaml.Unmarshal(data, &policy); err != nil {
+				t.Fatal(err)
+			}
+			for _, err := range checkSchema("", policy, schema) {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// checkSchema is a minimal validator covering the keywords the policy schema uses
+func checkSchema(path string, value interface{}, schema *spec.Schema) []error {
+	if _, ok := schema.Extensions.GetBool("x-kubernetes-preserve-unknown-fields"); ok {
+		return nil
+	}
+	if _, ok := schema.Extensions.GetBool("x-kubernetes-int-or-string"); ok {
+		switch value.(type) {
+		case float64, string:
+			return nil
+		}
+		return []error{fmt.Errorf("%s: expected an int or a string, got %v", path, value)}
+	}
+
+	var errs []error
+	switch {
+	case schema.Type.Contains("object"):
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{fmt.Errorf("%s: expected an object, got %v", path, value)}
+		}
+		if len(schema.OneOf) > 0 {
+			matched := false
+			for idx := range schema.OneOf {
+				if enum := schema.OneOf[idx].Properties["name"].Enum; len(enum) == 1 && enum[0] == object["name"] {
+					matched = true
+					if object["args"] == nil {
+						continue
+					}
+					errs = append(errs, checkSchema(path+".args", object["args"], ptrTo(schema.OneOf[idx].Properties["args"]))...)
+				}
+			}
+			if !matched {
+				errs = append(errs, fmt.Errorf("%s: unknown plugin %v", path, object["name"]))
+			}
+		}
+		for key, item := range object {
+			if property, ok := schema.Properties[key]; ok {
+				if len(schema.OneOf) == 0 || key != "args" {
+					errs = append(errs, checkSchema(path+"."+key, item, &property)...)
+				}
+			} else if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+				errs = append(errs, checkSchema(path+"."+key, item, schema.AdditionalProperties.Schema)...)
+			} else if item != nil {
+				errs = append(errs, fmt.Errorf("%s: unknown field %q", path, key))
+			}
+		}
+	case schema.Type.Contains("array"):
+		array, ok := value.([]interface{})
+		if !ok {
+			return []error{fmt.Errorf("%s: expected an array, got %v", path, value)}
+		}
+		for idx, item := range array {
+			errs = append(errs, checkSchema(fmt.Sprintf("%s[%d]", path, idx), item, schema.Items.Schema)...)
+		}
+	case schema.Type.Contains("string"):
+		if _, ok := value.(string); !ok {
+			errs = append(errs, fmt.Errorf("%s: expected a string, got %v", path, value))
+		}
+	case schema.Type.Contains("integer"), schema.Type.Contains("number"):
+		if _, ok := value.(float64); !ok {
+			errs = append(errs, fmt.Errorf("%s: expected a number, got %v", path, value))
+		}
+	case schema.Type.Contains("boolean"):
+		if _, ok := value.(bool); !ok {
+			errs = append(errs, fmt.Errorf("%s: expected a boolean, got %v", path, value))
+		}
+	}
+	return errs
+}
+
+// TestPolicyCustomResourceDefinition checks the definitions shipped with the manifests and the chart are up to date,
+// they are generated by: descheduler schema --format crd -o yaml
+func TestPolicyCustomResourceDefinition(t *testing.T) {
+	SetupPlugins()
+	crd, err := PolicyCustomResourceDefinition(pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to build the custom resource definition: %v", err)
+	}
+	if crd.Spec.Scope != apiextensionsv1.ClusterScoped || crd.Spec.Versions[0].Subresources.Status == nil {
+		t.Errorf("Expected a cluster scoped resource with the status subresource")
+	}
+	pluginConfig := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"].Properties["profiles"].Items.Schema.Properties["pluginConfig"].Items.Schema
+	if len(pluginConfig.OneOf) != 0 || pluginConfig.Properties["args"].XPreserveUnknownFields == nil {
+		t.Errorf("Expected the args of the plugins to be kept as they are, got %v", pluginConfig)
+	}
+
+	for _, file := range []string{"../../kubernetes/base/crd.yaml", "../../charts/descheduler/crds/deschedulerpolicies.yaml"} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shipped := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.UnmarshalStrict(data, shipped); err != nil {
+			t.Fatalf("Unable to decode %v: %v", file, err)
+		}
+		if diff := cmp.Diff(crd, shipped); diff != "" {
+			t.Errorf("%v is out of date, generate it with: descheduler schema --format crd -o yaml (-want +got):\n%s", file, diff)
+		}
+	}
+}
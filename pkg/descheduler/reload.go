@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/schedule"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	frameworkprofile "sigs.k8s.io/descheduler/pkg/framework/profile"
+)
+
+const (
+	// podNameEnv and podNamespaceEnv identify the descheduler pod the policy events are reported on
+	podNameEnv      = "POD_NAME"
+	podNamespaceEnv = "POD_NAMESPACE"
+)
+
+// reloadPolicy checks the policy file for changes and swaps the policy running between
+// descheduling cycles. A policy failing to load, or changing what is set up only when
+// the descheduler starts, is rejected and the policy running is kept. A rejected policy
+// is reported once, the file is loaded again only after it changes.
+func (d *descheduler) reloadPolicy(ctx context.Context) {
+	content, err := os.ReadFile(d.rs.PolicyConfigFile)
+	if err != nil {
+		klog.ErrorS(err, "unable to read the policy file, keeping the policy running", "file", d.rs.PolicyConfigFile)
+		return
+	}
+	if d.policyContent != nil && bytes.Equal(content, d.policyContent) {
+		return
+	}
+	d.policyContent = content
+
+	deschedulerPolicy, err := decode(d.rs.PolicyConfigFile, content, d.rs.Client, pluginregistry.PluginRegistry)
+	if err == nil {
+		// the file is checked for the first time or changed without changing the policy
+		if apiequality.Semantic.DeepEqual(deschedulerPolicy, d.deschedulerPolicy) {
+			return
+		}
+		err = d.applyPolicy(ctx, deschedulerPolicy)
+	}
+	d.reportReload(fmt.Sprintf("policy file %v", d.rs.PolicyConfigFile), deschedulerPolicy, err)
+}
+
+// reportReload reports the outcome of reloading the policy from the given source
+func (d *descheduler) reportReload(source string, deschedulerPolicy *api.DeschedulerPolicy, err error) {
+	if err != nil {
+		klog.ErrorS(err, "policy rejected, keeping the policy running", "source", source)
+		metrics.PolicyReloads.With(map[string]string{"result": "error"}).Inc()
+		d.recordEvent(v1.EventTypeWarning, "PolicyRejected", "PolicyReload", "%v rejected: %v", source, err)
+		return
+	}
+	klog.V(1).InfoS("Policy reloaded", "source", source, "profiles", len(deschedulerPolicy.Profiles))
+	metrics.PolicyReloads.With(map[string]string{"result": "success"}).Inc()
+	d.recordEvent(v1.EventTypeNormal, "PolicyReloaded", "PolicyReload", "%v reloaded", source)
+}
+
+// applyPolicy replaces the policy running. Everything that can fail, including the profiles
+// and their plugins, is built before anything is replaced, so the profiles, the eviction limits
+// and the metrics providers change together. The state spanning the cycles, e.g. the usage
+// smoothed by the metrics collector or the evictions requested in the background, is kept.
+func (d *descheduler) applyPolicy(ctx context.Context, deschedulerPolicy *api.DeschedulerPolicy) error {
+	if err := d.checkRestartRequired(deschedulerPolicy); err != nil {
+		return err
+	}
+	nodeSelector, err := policyNodeSelector(deschedulerPolicy)
+	if err != nil {
+		return err
+	}
+	scheduler, err := newProfileScheduler(deschedulerPolicy.Profiles, d.rs.DeschedulingInterval, time.Now())
+	if err != nil {
+		return err
+	}
+	if (scheduler == nil) != (d.scheduler == nil) {
+		return fmt.Errorf("adding or removing the schedules of all the profiles requires a restart")
+	}
+
+	if err := d.podEvictor.Reconfigure(newEvictionOptions(d.rs, deschedulerPolicy, d.evictionPolicyGroupVersion)); err != nil {
+		return err
+	}
+	parallelizer := parallelize.NewParallelizer(parallelize.DefaultParallelism)
+	if deschedulerPolicy.Concurrency != nil {
+		parallelizer = parallelize.NewParallelizer(int(*deschedulerPolicy.Concurrency))
+	}
+	profileRunners, err := d.buildProfileRunners(ctx, deschedulerPolicy, parallelizer)
+	if err != nil {
+		// the eviction options of the policy running are restored
+		if err := d.podEvictor.Reconfigure(newEvictionOptions(d.rs, d.deschedulerPolicy, d.evictionPolicyGroupVersion)); err != nil {
+			klog.ErrorS(err, "unable to restore the eviction options of the policy running")
+		}
+		return err
+	}
+
+	if scheduler != nil {
+		resumeProfileSchedules(scheduler, d.scheduler, d.deschedulerPolicy.Profiles, deschedulerPolicy.Profiles)
+	}
+	d.closeProfiles()
+	d.profileRunners = profileRunners
+	d.deschedulerPolicy = deschedulerPolicy
+	d.scheduler = scheduler
+	d.parallelizer = parallelizer
+	if d.metricsCollector != nil {
+		d.metricsCollector.SetNodeSelector(nodeSelector)
+	}
+
+	metricsProviders := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)
+	if !apiequality.Semantic.DeepEqual(metricsProviders[api.PrometheusMetrics], d.metricsProviders[api.PrometheusMetrics]) {
+		// the prometheus client is created again for the new provider
+		d.currentPrometheusAuthToken = ""
+		if prometheusTokenReconciliation(deschedulerPolicy) == secretReconciliation {
+			d.queue.Add(workQueueKey)
+		}
+	}
+	d.metricsProviders = metricsProviders
+	return nil
+}
+
+// buildProfileRunners sets up the informers the policy needs, then builds and initializes
+// all the profiles of the policy. The profiles already built are closed when any of them fails.
+func (d *descheduler) buildProfileRunners(ctx context.Context, deschedulerPolicy *api.DeschedulerPolicy, parallelizer parallelize.Parallelizer) ([]*profileRunner, error) {
+	if err := d.ir.Uses(informedResources(deschedulerPolicy)...); err != nil {
+		return nil, fmt.Errorf("unable to set up informers: %v", err)
+	}
+	d.ir.sharedInformerFactory.Start(ctx.Done())
+	d.ir.sharedInformerFactory.WaitForCacheSync(ctx.Done())
+	if d.ir.dynamicInformerFactory != nil {
+		d.ir.dynamicInformerFactory.Start(ctx.Done())
+		d.ir.dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+	}
+
+	// the handles are updated with the client and informers of each cycle before the profiles run
+	handleOpts := append(d.handleOptions(d.rs.Client), frameworkprofile.WithParallelism(parallelizer.Parallelism()))
+	profileRunners := make([]*profileRunner, 0, len(deschedulerPolicy.Profiles))
+	for _, profile := range deschedulerPolicy.Profiles {
+		profileR, err := d.buildProfileRunner(ctx, profile, handleOpts...)
+		if err != nil {
+			closeProfileRunners(profileRunners)
+			return nil, fmt.Errorf("unable to create profile %q: %v", profile.Name, err)
+		}
+		profileRunners = append(profileRunners, profileR)
+	}
+	return profileRunners, nil
+}
+
+// resumeProfileSchedules keeps the next runs of the profiles whose schedule did not change,
+// the changed and the new profiles are scheduled from the time of the reload
+func resumeProfileSchedules(scheduler, previous *schedule.Scheduler, previousProfiles, profiles []api.DeschedulerProfile) {
+	previousSchedules := map[string]*api.ProfileSchedule{}
+	for _, profile := range previousProfiles {
+		previousSchedules[profile.Name] = profile.Schedule
+	}
+	for _, profile := range profiles {
+		if previousSchedule, ok := previousSchedules[profile.Name]; ok && apiequality.Semantic.DeepEqual(previousSchedule, profile.Schedule) {
+			scheduler.Resume(profile.Name, previous)
+		}
+	}
+}
+
+// checkRestartRequired returns an error when the policy changes anything set up only when the descheduler starts
+func (d *descheduler) checkRestartRequired(deschedulerPolicy *api.DeschedulerPolicy) error {
+	if usesMetricsServer(deschedulerPolicy) && d.rs.MetricsClient == nil {
+		return fmt.Errorf("enabling the metrics server metrics requires a restart")
+	}
+	reconciliation := prometheusTokenReconciliation(deschedulerPolicy)
+	if reconciliation != prometheusTokenReconciliation(d.deschedulerPolicy) {
+		return fmt.Errorf("changing the authentication of the prometheus metrics provider requires a restart")
+	}
+	if reconciliation == secretReconciliation {
+		secret := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.PrometheusMetrics].Prometheus.AuthToken.SecretReference
+		current := d.metricsProviders[api.PrometheusMetrics].Prometheus.AuthToken.SecretReference
+		if secret == nil || current == nil || secret.Namespace != current.Namespace {
+			return fmt.Errorf("changing the namespace of the prometheus authentication token secret requires a restart")
+		}
+	}
+	return nil
+}
+
+// recordEvent reports an event on the descheduler pod, when the pod is known
+func (d *descheduler) recordEvent(eventType, reason, action, note string, args ...interface{}) {
+	name, namespace := os.Getenv(podNameEnv), os.Getenv(podNamespaceEnv)
+	if name == "" || namespace == "" {
+		klog.V(3).InfoS("Event not reported, the descheduler pod is unknown", "reason", reason, "env", []string{podNameEnv, podNamespaceEnv})
+		return
+	}
+	pod := &v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: namespace, Name: name}
+	d.eventRecorder.Eventf(pod, nil, eventType, reason, action, note, args...)
+}
+
+func policyNodeSelector(deschedulerPolicy *api.DeschedulerPolicy) (labels.Selector, error) {
+	if deschedulerPolicy.NodeSelector == nil {
+		return labels.Everything(), nil
+	}
+	return labels.Parse(*deschedulerPolicy.NodeSelector)
+}
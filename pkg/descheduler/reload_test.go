@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/component-base/metrics/testutil"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+	fakeplugin "sigs.k8s.io/descheduler/pkg/framework/fake/plugin"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+const reloadPolicyTemplate = `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+maxNoOfPodsToEvictPerNode: 1
+profiles:
+  - name: Profile
+    pluginConfig:
+    - name: "RemovePodsViolatingNodeTaints"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsViolatingNodeTaints"
+`
+
+func TestReloadPolicy(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+	t.Setenv(podNameEnv, "descheduler")
+	t.Setenv(podNamespaceEnv, "kube-system")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	policyFile := writePolicy(t, reloadPolicyTemplate)
+	deschedulerPolicy, err := LoadPolicyConfig(policyFile, fakeclientset.NewSimpleClientset(), pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to load the policy: %v", err)
+	}
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	rs, descheduler, _ := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, node1, node2)
+	defer descheduler.closeProfiles()
+	rs.PolicyConfigFile = policyFile
+	eventRecorder := events.NewFakeRecorder(10)
+	descheduler.eventRecorder = eventRecorder
+
+	reloads := func(result string) float64 {
+		t.Helper()
+		value, err := testutil.GetCounterMetricValue(metrics.PolicyReloads.With(map[string]string{"result": result}))
+		if err != nil {
+			t.Fatalf("Unable to get the policy reloads metric: %v", err)
+		}
+		return value
+	}
+	succeeded, failed := reloads("success"), reloads("error")
+
+	update := func(policy string) {
+		t.Helper()
+		if err := os.WriteFile(policyFile, []byte(policy), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		descheduler.reloadPolicy(ctx)
+	}
+
+	// the policy loaded when the descheduler started is kept
+	descheduler.reloadPolicy(ctx)
+	if descheduler.deschedulerPolicy != deschedulerPolicy {
+		t.Errorf("Expected the policy running to be kept when the file did not change")
+	}
+	if err := descheduler.runDeschedulerLoop(ctx, []*v1.Node{node1, node2}); err != nil {
+		t.Fatalf("Unable to run a descheduling cycle: %v", err)
+	}
+	if len(descheduler.profileRunners) != 1 {
+		t.Fatalf("Expected the profile to be built, got %v profiles", len(descheduler.profileRunners))
+	}
+
+	update(`apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+maxNoOfPodsToEvictPerNode: 5
+profiles:
+  - name: Reloaded
+    pluginConfig:
+    - name: "RemovePodsViolatingNodeTaints"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsViolatingNodeTaints"
+`)
+	if got := descheduler.deschedulerPolicy.Profiles[0].Name; got != "Reloaded" {
+		t.Errorf("Expected the reloaded profile, got %v", got)
+	}
+	if got := *descheduler.deschedulerPolicy.MaxNoOfPodsToEvictPerNode; got != 5 {
+		t.Errorf("Expected the reloaded limit of 5 evictions per node, got %v", got)
+	}
+	if len(descheduler.profileRunners) != 1 || descheduler.profileRunners[0].name != "Reloaded" {
+		t.Errorf("Expected the profiles of the reloaded policy to be built")
+	}
+	if got := reloads("success") - succeeded; got != 1 {
+		t.Errorf("Expected 1 successful reload, got %v", got)
+	}
+
+	// an invalid policy is rejected, also a policy enabling what is set up only when the descheduler starts
+	running := descheduler.deschedulerPolicy
+	update(reloadPolicyTemplate + `concurrency: 0
+`)
+	update(reloadPolicyTemplate + `metricsCollector:
+  enabled: true
+`)
+	if descheduler.deschedulerPolicy != running {
+		t.Errorf("Expected the policy running to be kept")
+	}
+	if got := reloads("error") - failed; got != 2 {
+		t.Errorf("Expected 2 rejected policies, got %v", got)
+	}
+
+	// a rejected policy is reported once
+	descheduler.reloadPolicy(ctx)
+	if got := reloads("error") - failed; got != 2 {
+		t.Errorf("Expected the rejected policy not to be reported again, got %v rejected policies", got)
+	}
+
+	expectedEvents := []string{
+		"Normal PolicyReloaded policy file " + policyFile + " reloaded",
+		"Warning PolicyRejected policy file " + policyFile + " rejected: concurrency must be greater than 0",
+		"Warning PolicyRejected policy file " + policyFile + " rejected: enabling the metrics server metrics requires a restart",
+	}
+	close(eventRecorder.Events)
+	var events []string
+	for event := range eventRecorder.Events {
+		// the descheduling cycles report their own events
+		if strings.HasPrefix(strings.Fields(event)[1], "Policy") {
+			events = append(events, event)
+		}
+	}
+	if diff := cmp.Diff(expectedEvents, events); diff != "" {
+		t.Errorf("Unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestResumeProfileSchedules(t *testing.T) {
+	start := time.Date(2026, 3, 6, 0, 30, 0, 0, time.UTC)
+	hourly := &api.ProfileSchedule{Interval: &metav1.Duration{Duration: time.Hour}}
+	profiles := []api.DeschedulerProfile{
+		{Name: "hourly", Schedule: hourly},
+		{Name: "nightly", Schedule: &api.ProfileSchedule{Cron: "0 2 * * *"}},
+	}
+	previous, err := newProfileScheduler(profiles, 0, start)
+	if err != nil {
+		t.Fatalf("Unable to schedule the profiles: %v", err)
+	}
+	// the hourly profile runs right away and is due again at 1:30
+	previous.Due(start)
+
+	reloadTime := start.Add(10 * time.Minute)
+	reloaded := []api.DeschedulerProfile{
+		{Name: "hourly", Schedule: hourly.DeepCopy()},
+		{Name: "nightly", Schedule: &api.ProfileSchedule{Cron: "0 1 * * *"}},
+		{Name: "added", Schedule: hourly.DeepCopy()},
+	}
+	scheduler, err := newProfileScheduler(reloaded, 0, reloadTime)
+	if err != nil {
+		t.Fatalf("Unable to schedule the profiles: %v", err)
+	}
+	resumeProfileSchedules(scheduler, previous, profiles, reloaded)
+
+	steps := []struct {
+		now         time.Time
+		expectedDue []string
+	}{
+		// the unchanged hourly profile is not restarted by the reload
+		{now: reloadTime, expectedDue: []string{"added"}},
+		{now: start.Add(30 * time.Minute), expectedDue: []string{"nightly"}},
+		{now: start.Add(time.Hour), expectedDue: []string{"hourly"}},
+	}
+	for _, step := range steps {
+		if diff := cmp.Diff(step.expectedDue, sets.List(scheduler.Due(step.now))); diff != "" {
+			t.Errorf("Unexpected profiles due at %v (-want +got):\n%s", step.now, diff)
+		}
+	}
+}
+
+func TestApplyPolicyPluginFailure(t *testing.T) {
+	initPluginRegistry()
+	lifecyclePlugin := &fakeplugin.FakeLifecyclePlugin{PluginName: "Lifecycle"}
+	pluginregistry.Register(
+		lifecyclePlugin.PluginName,
+		fakeplugin.NewFakeLifecyclePluginFncFromFake(lifecyclePlugin),
+		&fakeplugin.FakeLifecyclePlugin{},
+		&fakeplugin.FakeLifecyclePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+	pluginregistry.Register(
+		"Failing",
+		func(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+			return nil, fmt.Errorf("plugin failed")
+		},
+		&fakeplugin.FakeDeschedulePlugin{},
+		&fakeplugin.FakeDeschedulePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	deschedulerPolicy := removePodsViolatingNodeTaintsPolicy()
+	_, descheduler, _ := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, node1, node2)
+	defer descheduler.closeProfiles()
+	if err := descheduler.runDeschedulerLoop(ctx, []*v1.Node{node1, node2}); err != nil {
+		t.Fatalf("Unable to run a descheduling cycle: %v", err)
+	}
+	running := descheduler.profileRunners
+
+	// the first profile gets built before the plugin of the second one fails
+	err := descheduler.applyPolicy(ctx, &api.DeschedulerPolicy{
+		MaxNoOfPodsToEvictPerNode: utilptr.To[uint](5),
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name:          "Built",
+				PluginConfigs: []api.PluginConfig{{Name: lifecyclePlugin.PluginName, Args: &fakeplugin.FakeLifecyclePluginArgs{}}},
+				Plugins:       api.Plugins{Deschedule: api.PluginSet{Enabled: []string{lifecyclePlugin.PluginName}}},
+			},
+			{
+				Name:          "Failing",
+				PluginConfigs: []api.PluginConfig{{Name: "Failing", Args: &fakeplugin.FakeDeschedulePluginArgs{}}},
+				Plugins:       api.Plugins{Deschedule: api.PluginSet{Enabled: []string{"Failing"}}},
+			},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "plugin failed") {
+		t.Fatalf("Expected the policy to be rejected due to the failing plugin, got %v", err)
+	}
+	if descheduler.deschedulerPolicy != deschedulerPolicy {
+		t.Errorf("Expected the policy running to be kept")
+	}
+	if len(descheduler.profileRunners) != len(running) || descheduler.profileRunners[0] != running[0] {
+		t.Errorf("Expected the profiles of the policy running to be kept")
+	}
+	if diff := cmp.Diff([]string{"New", "Init", "Close"}, lifecyclePlugin.Calls); diff != "" {
+		t.Errorf("Expected the profile built for the rejected policy to be closed (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears bounds the search for the next matching time,
+// e.g. "0 0 30 2 *" (February 30th) never matches.
+const cronSearchYears = 5
+
+// bits of the values matched by a cron field
+type bits uint64
+
+func (b bits) has(value int) bool {
+	return b&(1<<uint(value)) != 0
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField     = cronField{name: "minute", min: 0, max: 59}
+	hourField       = cronField{name: "hour", min: 0, max: 23}
+	dayOfMonthField = cronField{name: "day of month", min: 1, max: 31}
+	monthField      = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted as Sunday as well
+	dayOfWeekField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Cron is a schedule given by a standard five field cron expression
+// (minute, hour, day of month, month and day of week) evaluated in a time zone.
+type Cron struct {
+	minute, hour, dayOfMonth, month, dayOfWeek bits
+	// a day matches when either of the day fields matches if both are restricted
+	dayOfMonthAny, dayOfWeekAny bool
+	location                    *time.Location
+}
+
+var _ Schedule = &Cron{}
+
+// ParseCron parses a standard five field cron expression, e.g. "30 2 * * MON-FRI".
+// Lists, ranges, steps, month and day of week names and the @yearly, @monthly,
+// @weekly, @daily and @hourly macros are supported. A nil location means UTC.
+func ParseCron(spec string, location *time.Location) (*Cron, error) {
+	if location == nil {
+		location = time.UTC
+	}
+	expression := strings.TrimSpace(spec)
+	if macro, ok := cronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %v", spec, len(fields))
+	}
+
+	c := &Cron{location: location}
+	var err error
+	if c.minute, _, err = minuteField.parse(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", spec, err)
+	}
+	if c.hour, _, err = hourField.parse(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", spec, err)
+	}
+	if c.dayOfMonth, c.dayOfMonthAny, err = dayOfMonthField.parse(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", spec, err)
+	}
+	if c.month, _, err = monthField.parse(fields[3]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", spec, err)
+	}
+	if c.dayOfWeek, c.dayOfWeekAny, err = dayOfWeekField.parse(fields[4]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", spec, err)
+	}
+	if c.dayOfWeek.has(7) {
+		c.dayOfWeek |= 1 << 0
+	}
+	return c, nil
+}
+
+// parse parses a comma separated list of values, ranges and steps.
+// Reports whether the field matches any value ("*" or "?").
+func (f cronField) parse(field string) (bits, bool, error) {
+	if field == "*" || field == "?" {
+		return f.span(f.min, f.max, 1), true, nil
+	}
+	var result bits
+	for _, part := range strings.Split(field, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, false, err
+		}
+		result |= b
+	}
+	return result, false, nil
+}
+
+func (f cronField) parsePart(part string) (bits, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid %v step %q", f.name, stepPart)
+		}
+	}
+
+	var low, high int
+	switch {
+	case rangePart == "*" || rangePart == "?":
+		low, high = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		lowPart, highPart, _ := strings.Cut(rangePart, "-")
+		var err error
+		if low, err = f.value(lowPart); err != nil {
+			return 0, err
+		}
+		if high, err = f.value(highPart); err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, fmt.Errorf("invalid %v range %q", f.name, rangePart)
+		}
+	default:
+		var err error
+		if low, err = f.value(rangePart); err != nil {
+			return 0, err
+		}
+		high = low
+		// "a/n" means from a to the maximum every n
+		if hasStep {
+			high = f.max
+		}
+	}
+	return f.span(low, high, step), nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v value %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%v value %v out of range [%v, %v]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (f cronField) span(low, high, step int) bits {
+	var b bits
+	for v := low; v <= high; v += step {
+		b |= 1 << uint(v)
+	}
+	return b
+}
+
+// First returns the first time the expression matches after t
+func (c *Cron) First(t time.Time) time.Time {
+	return c.Next(t)
+}
+
+// Next returns the first time the expression matches after t.
+// The zero time is returned when there is no such time in the next few years.
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.In(c.location).Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + cronSearchYears
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+	for !c.month.has(int(t.Month())) {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.location)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+	for !c.dayMatches(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+	for !c.hour.has(t.Hour()) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.location)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+	for !c.minute.has(t.Minute()) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, c.location)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+	return t
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	dayOfMonth := c.dayOfMonth.has(t.Day())
+	dayOfWeek := c.dayOfWeek.has(int(t.Weekday()))
+	if c.dayOfMonthAny || c.dayOfWeekAny {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
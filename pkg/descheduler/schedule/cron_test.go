@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	prague, err := time.LoadLocation("Europe/Prague")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	tests := []struct {
+		description string
+		spec        string
+		location    *time.Location
+		from        time.Time
+		expected    time.Time
+	}{
+		{
+			description: "every minute",
+			spec:        "* * * * *",
+			from:        time.Date(2026, 3, 4, 10, 15, 30, 0, time.UTC),
+			expected:    time.Date(2026, 3, 4, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			description: "every 10 minutes",
+			spec:        "*/10 * * * *",
+			from:        time.Date(2026, 3, 4, 10, 20, 0, 0, time.UTC),
+			expected:    time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			description: "nightly",
+			spec:        "0 2 * * *",
+			from:        time.Date(2026, 3, 4, 10, 15, 0, 0, time.UTC),
+			expected:    time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "nightly macro",
+			spec:        "@daily",
+			from:        time.Date(2026, 12, 31, 10, 15, 0, 0, time.UTC),
+			expected:    time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "weekends only",
+			spec:        "0 */6 * * SAT,SUN",
+			// Wednesday
+			from:     time.Date(2026, 3, 4, 10, 15, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "sunday as 7",
+			spec:        "30 1 * * 7",
+			from:        time.Date(2026, 3, 4, 10, 15, 0, 0, time.UTC),
+			expected:    time.Date(2026, 3, 8, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			description: "working days range",
+			spec:        "0 9 * * mon-fri",
+			// Friday after 9 am
+			from:     time.Date(2026, 3, 6, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "day of month or day of week",
+			spec:        "0 0 15 * MON",
+			// Tuesday the 10th
+			from:     time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "leap day",
+			spec:        "0 0 29 FEB *",
+			from:        time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected:    time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "never",
+			spec:        "0 0 30 2 *",
+			from:        time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected:    time.Time{},
+		},
+		{
+			description: "time zone",
+			spec:        "0 2 * * *",
+			location:    prague,
+			from:        time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			expected:    time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			cron, err := ParseCron(tc.spec, tc.location)
+			if err != nil {
+				t.Fatalf("Unable to parse %q: %v", tc.spec, err)
+			}
+			if next := cron.Next(tc.from); !next.Equal(tc.expected) {
+				t.Errorf("Expected next run at %v, got %v instead", tc.expected, next)
+			}
+		})
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	tests := []struct {
+		spec        string
+		expectedErr string
+	}{
+		{
+			spec:        "* * * *",
+			expectedErr: `invalid cron expression "* * * *": expected 5 fields, got 4`,
+		},
+		{
+			spec:        "60 * * * *",
+			expectedErr: `invalid cron expression "60 * * * *": minute value 60 out of range [0, 59]`,
+		},
+		{
+			spec:        "* * 0 * *",
+			expectedErr: `invalid cron expression "* * 0 * *": day of month value 0 out of range [1, 31]`,
+		},
+		{
+			spec:        "*/0 * * * *",
+			expectedErr: `invalid cron expression "*/0 * * * *": invalid minute step "0"`,
+		},
+		{
+			spec:        "* 5-2 * * *",
+			expectedErr: `invalid cron expression "* 5-2 * * *": invalid hour range "5-2"`,
+		},
+		{
+			spec:        "* * * * FUN",
+			expectedErr: `invalid cron expression "* * * * FUN": invalid day of week value "FUN"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.spec, func(t *testing.T) {
+			_, err := ParseCron(tc.spec, nil)
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("Expected error %q, got %v instead", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// Schedule computes the times a profile is due to run at
+type Schedule interface {
+	// First returns the first time the profile is due given the scheduling starts at t
+	First(t time.Time) time.Time
+	// Next returns the first time after t the profile is due.
+	// The zero time means the profile is not due anymore.
+	Next(t time.Time) time.Time
+}
+
+// Every returns a schedule running a profile right away and then every interval
+func Every(interval time.Duration) Schedule {
+	return every(interval)
+}
+
+type every time.Duration
+
+func (e every) First(t time.Time) time.Time {
+	return t
+}
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// Once returns a schedule running a profile right away only
+func Once() Schedule {
+	return once{}
+}
+
+type once struct{}
+
+func (o once) First(t time.Time) time.Time {
+	return t
+}
+
+func (o once) Next(t time.Time) time.Time {
+	return time.Time{}
+}
+
+// New returns the schedule configured for a profile
+func New(in *api.ProfileSchedule) (Schedule, error) {
+	if in.Jitter != nil && in.Jitter.Duration < 0 {
+		return nil, fmt.Errorf("jitter must not be negative")
+	}
+	switch {
+	case in.Cron != "" && in.Interval != nil:
+		return nil, fmt.Errorf("only one of cron and interval can be set")
+	case in.Interval != nil:
+		if in.TimeZone != nil {
+			return nil, fmt.Errorf("timeZone can be set only together with cron")
+		}
+		if in.Interval.Duration <= 0 {
+			return nil, fmt.Errorf("interval must be greater than 0")
+		}
+		return Every(in.Interval.Duration), nil
+	case in.Cron != "":
+		location := time.UTC
+		if in.TimeZone != nil {
+			var err error
+			location, err = time.LoadLocation(*in.TimeZone)
+			if err != nil {
+				return nil, fmt.Errorf("unable to load timeZone %q: %v", *in.TimeZone, err)
+			}
+		}
+		return ParseCron(in.Cron, location)
+	default:
+		return nil, fmt.Errorf("one of cron and interval is required")
+	}
+}
+
+type entry struct {
+	name     string
+	schedule Schedule
+	jitter   time.Duration
+	// scheduled is the time the profile is due at according to its schedule
+	scheduled time.Time
+	// next is the scheduled time delayed by a random jitter
+	next time.Time
+}
+
+// Scheduler keeps track of the times profiles are due to run at
+type Scheduler struct {
+	entries []*entry
+	// randInt63n returns a random number in [0, n), replaced in tests
+	randInt63n func(n int64) int64
+}
+
+// NewScheduler returns a scheduler with no profiles
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		randInt63n: rand.Int63n,
+	}
+}
+
+// Add schedules a profile. Every run is delayed by a random duration up to jitter
+// so descheduler instances of many clusters do not run at the same moment.
+func (s *Scheduler) Add(name string, schedule Schedule, jitter time.Duration, now time.Time) {
+	e := &entry{
+		name:      name,
+		schedule:  schedule,
+		jitter:    jitter,
+		scheduled: schedule.First(now),
+	}
+	e.next = s.withJitter(e)
+	klog.V(2).InfoS("Profile scheduled", "profile", name, "nextRun", e.next)
+	s.entries = append(s.entries, e)
+}
+
+// Resume carries the next run of a profile over from a previous scheduler, e.g.
+// of the policy before a reload, so the unchanged schedule is not restarted.
+// It reports whether both schedulers know the profile.
+func (s *Scheduler) Resume(name string, previous *Scheduler) bool {
+	from := previous.entry(name)
+	to := s.entry(name)
+	if from == nil || to == nil {
+		return false
+	}
+	to.scheduled, to.next = from.scheduled, from.next
+	klog.V(2).InfoS("Profile schedule resumed", "profile", name, "nextRun", to.next)
+	return true
+}
+
+func (s *Scheduler) entry(name string) *entry {
+	for _, e := range s.entries {
+		if e.name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// NextRun returns the earliest time any of the profiles is due.
+// The zero time is returned when no profile is due anymore.
+func (s *Scheduler) NextRun() time.Time {
+	var next time.Time
+	for _, e := range s.entries {
+		if e.next.IsZero() {
+			continue
+		}
+		if next.IsZero() || e.next.Before(next) {
+			next = e.next
+		}
+	}
+	return next
+}
+
+// Due returns the profiles due at now and schedules their next runs.
+// Runs missed e.g. due to a long descheduling cycle are skipped.
+func (s *Scheduler) Due(now time.Time) sets.Set[string] {
+	due := sets.New[string]()
+	for _, e := range s.entries {
+		if e.next.IsZero() || e.next.After(now) {
+			continue
+		}
+		due.Insert(e.name)
+		for !e.scheduled.IsZero() && !e.scheduled.After(now) {
+			e.scheduled = e.schedule.Next(e.scheduled)
+		}
+		e.next = s.withJitter(e)
+		klog.V(2).InfoS("Profile scheduled", "profile", e.name, "nextRun", e.next)
+	}
+	return due
+}
+
+func (s *Scheduler) withJitter(e *entry) time.Time {
+	if e.scheduled.IsZero() || e.jitter <= 0 {
+		return e.scheduled
+	}
+	return e.scheduled.Add(time.Duration(s.randInt63n(int64(e.jitter))))
+}
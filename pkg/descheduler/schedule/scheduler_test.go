@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestSchedulerDue(t *testing.T) {
+	start := time.Date(2026, 3, 6, 23, 55, 0, 0, time.UTC)
+	nightly, err := ParseCron("0 2 * * *", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	weekends, err := ParseCron("0 0 * * SAT,SUN", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheduler := NewScheduler()
+	scheduler.Add("PodLifeTime", nightly, 0, start)
+	scheduler.Add("LowNodeUtilization", Every(10*time.Minute), 0, start)
+	scheduler.Add("HighNodeUtilization", weekends, 0, start)
+	scheduler.Add("RemoveDuplicates", Once(), 0, start)
+
+	steps := []struct {
+		now         time.Time
+		expectedDue []string
+		nextRun     time.Time
+	}{
+		{
+			now:         start,
+			expectedDue: []string{"LowNodeUtilization", "RemoveDuplicates"},
+			nextRun:     start.Add(5 * time.Minute),
+		},
+		{
+			now:         start.Add(5 * time.Minute),
+			expectedDue: []string{"HighNodeUtilization"},
+			nextRun:     start.Add(10 * time.Minute),
+		},
+		{
+			// the runs missed in between are skipped
+			now:         start.Add(2*time.Hour + 7*time.Minute),
+			expectedDue: []string{"LowNodeUtilization", "PodLifeTime"},
+			nextRun:     start.Add(2*time.Hour + 10*time.Minute),
+		},
+		{
+			now:         start.Add(2*time.Hour + 8*time.Minute),
+			expectedDue: []string{},
+			nextRun:     start.Add(2*time.Hour + 10*time.Minute),
+		},
+		{
+			now:         start.Add(2*time.Hour + 10*time.Minute),
+			expectedDue: []string{"LowNodeUtilization"},
+			nextRun:     start.Add(2*time.Hour + 20*time.Minute),
+		},
+	}
+	for _, step := range steps {
+		due := scheduler.Due(step.now)
+		if diff := cmp.Diff(step.expectedDue, sets.List(due)); diff != "" {
+			t.Errorf("Unexpected profiles due at %v (-want +got):\n%s", step.now, diff)
+		}
+		if next := scheduler.NextRun(); !next.Equal(step.nextRun) {
+			t.Errorf("Expected next run at %v, got %v instead", step.nextRun, next)
+		}
+	}
+}
+
+func TestSchedulerJitter(t *testing.T) {
+	start := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler()
+	scheduler.randInt63n = func(n int64) int64 {
+		return n / 2
+	}
+	scheduler.Add("Profile", Every(time.Hour), 10*time.Minute, start)
+
+	if next := scheduler.NextRun(); !next.Equal(start.Add(5 * time.Minute)) {
+		t.Errorf("Expected the first run delayed by the jitter, got %v instead", next)
+	}
+	if due := scheduler.Due(start); due.Len() != 0 {
+		t.Errorf("Expected no profile due before the jitter elapses, got %v instead", sets.List(due))
+	}
+	scheduler.Due(start.Add(5 * time.Minute))
+	// the jitter does not accumulate across the runs
+	if next := scheduler.NextRun(); !next.Equal(start.Add(time.Hour + 5*time.Minute)) {
+		t.Errorf("Expected the next run at %v, got %v instead", start.Add(time.Hour+5*time.Minute), next)
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		description string
+		schedule    *api.ProfileSchedule
+		expectedErr string
+	}{
+		{
+			description: "cron with a time zone",
+			schedule:    &api.ProfileSchedule{Cron: "0 2 * * *", TimeZone: utilptr.To("UTC")},
+		},
+		{
+			description: "interval with a jitter",
+			schedule:    &api.ProfileSchedule{Interval: &metav1.Duration{Duration: time.Minute}, Jitter: &metav1.Duration{Duration: time.Second}},
+		},
+		{
+			description: "neither cron nor interval",
+			schedule:    &api.ProfileSchedule{},
+			expectedErr: "one of cron and interval is required",
+		},
+		{
+			description: "both cron and interval",
+			schedule:    &api.ProfileSchedule{Cron: "0 2 * * *", Interval: &metav1.Duration{Duration: time.Minute}},
+			expectedErr: "only one of cron and interval can be set",
+		},
+		{
+			description: "time zone with interval",
+			schedule:    &api.ProfileSchedule{Interval: &metav1.Duration{Duration: time.Minute}, TimeZone: utilptr.To("UTC")},
+			expectedErr: "timeZone can be set only together with cron",
+		},
+		{
+			description: "zero interval",
+			schedule:    &api.ProfileSchedule{Interval: &metav1.Duration{}},
+			expectedErr: "interval must be greater than 0",
+		},
+		{
+			description: "negative jitter",
+			schedule:    &api.ProfileSchedule{Cron: "@hourly", Jitter: &metav1.Duration{Duration: -time.Second}},
+			expectedErr: "jitter must not be negative",
+		},
+		{
+			description: "unknown time zone",
+			schedule:    &api.ProfileSchedule{Cron: "@hourly", TimeZone: utilptr.To("Mars/Olympus")},
+			expectedErr: `unable to load timeZone "Mars/Olympus": unknown time zone Mars/Olympus`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := New(tc.schedule)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("Expected error %q, got %v instead", tc.expectedErr, err)
+			}
+		})
+	}
+}
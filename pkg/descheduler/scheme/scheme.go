@@ -25,8 +25,10 @@ import (
 	"sigs.k8s.io/descheduler/pkg/apis/componentconfig"
 	componentconfigv1alpha1 "sigs.k8s.io/descheduler/pkg/apis/componentconfig/v1alpha1"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/external"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsorting"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removefailedpods"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodshavingtoomanyrestarts"
@@ -39,6 +41,8 @@ import (
 var (
 	Scheme = runtime.NewScheme()
 	Codecs = serializer.NewCodecFactory(Scheme)
+	// StrictCodecs reject the unknown and duplicated fields
+	StrictCodecs = serializer.NewCodecFactory(Scheme, serializer.EnableStrict)
 )
 
 func init() {
@@ -46,6 +50,8 @@ func init() {
 	utilruntime.Must(defaultevictor.AddToScheme(Scheme))
 	utilruntime.Must(nodeutilization.AddToScheme(Scheme))
 	utilruntime.Must(podlifetime.AddToScheme(Scheme))
+	utilruntime.Must(podsorting.AddToScheme(Scheme))
+	utilruntime.Must(external.AddToScheme(Scheme))
 	utilruntime.Must(removeduplicates.AddToScheme(Scheme))
 	utilruntime.Must(removefailedpods.AddToScheme(Scheme))
 	utilruntime.Must(removepodshavingtoomanyrestarts.AddToScheme(Scheme))
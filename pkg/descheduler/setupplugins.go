@@ -19,8 +19,10 @@ package descheduler
 import (
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/external"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsorting"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removefailedpods"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodshavingtoomanyrestarts"
@@ -47,4 +49,11 @@ func RegisterDefaultPlugins(registry pluginregistry.Registry) {
 	pluginregistry.Register(removepodsviolatingnodeaffinity.PluginName, removepodsviolatingnodeaffinity.New, &removepodsviolatingnodeaffinity.RemovePodsViolatingNodeAffinity{}, &removepodsviolatingnodeaffinity.RemovePodsViolatingNodeAffinityArgs{}, removepodsviolatingnodeaffinity.ValidateRemovePodsViolatingNodeAffinityArgs, removepodsviolatingnodeaffinity.SetDefaults_RemovePodsViolatingNodeAffinityArgs, registry)
 	pluginregistry.Register(removepodsviolatingnodetaints.PluginName, removepodsviolatingnodetaints.New, &removepodsviolatingnodetaints.RemovePodsViolatingNodeTaints{}, &removepodsviolatingnodetaints.RemovePodsViolatingNodeTaintsArgs{}, removepodsviolatingnodetaints.ValidateRemovePodsViolatingNodeTaintsArgs, removepodsviolatingnodetaints.SetDefaults_RemovePodsViolatingNodeTaintsArgs, registry)
 	pluginregistry.Register(removepodsviolatingtopologyspreadconstraint.PluginName, removepodsviolatingtopologyspreadconstraint.New, &removepodsviolatingtopologyspreadconstraint.RemovePodsViolatingTopologySpreadConstraint{}, &removepodsviolatingtopologyspreadconstraint.RemovePodsViolatingTopologySpreadConstraintArgs{}, removepodsviolatingtopologyspreadconstraint.ValidateRemovePodsViolatingTopologySpreadConstraintArgs, removepodsviolatingtopologyspreadconstraint.SetDefaults_RemovePodsViolatingTopologySpreadConstraintArgs, registry)
+	pluginregistry.Register(podsorting.PrioritySortPluginName, podsorting.NewPrioritySort, &podsorting.PrioritySort{}, &podsorting.PrioritySortArgs{}, podsorting.ValidatePrioritySortArgs, podsorting.SetDefaults_PrioritySortArgs, registry)
+	pluginregistry.Register(podsorting.QoSClassSortPluginName, podsorting.NewQoSClassSort, &podsorting.QoSClassSort{}, &podsorting.QoSClassSortArgs{}, podsorting.ValidateQoSClassSortArgs, podsorting.SetDefaults_QoSClassSortArgs, registry)
+	pluginregistry.Register(podsorting.AgeSortPluginName, podsorting.NewAgeSort, &podsorting.AgeSort{}, &podsorting.AgeSortArgs{}, podsorting.ValidateAgeSortArgs, podsorting.SetDefaults_AgeSortArgs, registry)
+	pluginregistry.Register(podsorting.RestartCountSortPluginName, podsorting.NewRestartCountSort, &podsorting.RestartCountSort{}, &podsorting.RestartCountSortArgs{}, podsorting.ValidateRestartCountSortArgs, podsorting.SetDefaults_RestartCountSortArgs, registry)
+	pluginregistry.Register(podsorting.DeletionCostSortPluginName, podsorting.NewDeletionCostSort, &podsorting.DeletionCostSort{}, &podsorting.DeletionCostSortArgs{}, podsorting.ValidateDeletionCostSortArgs, podsorting.SetDefaults_DeletionCostSortArgs, registry)
+	pluginregistry.Register(podsorting.StartupTimeSortPluginName, podsorting.NewStartupTimeSort, &podsorting.StartupTimeSort{}, &podsorting.StartupTimeSortArgs{}, podsorting.ValidateStartupTimeSortArgs, podsorting.SetDefaults_StartupTimeSortArgs, registry)
+	pluginregistry.Register(external.PluginName, external.New, &external.External{}, &external.ExternalArgs{}, external.ValidateExternalArgs, external.SetDefaults_ExternalArgs, registry)
 }
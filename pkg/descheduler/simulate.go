@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/simulation"
+	"sigs.k8s.io/descheduler/pkg/descheduler/snapshot"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+// snapshotResources are exported into every snapshot on top of the resources the descheduler is informed of
+var snapshotResources = []schema.GroupVersionResource{
+	v1.SchemeGroupVersion.WithResource("namespaces"),
+	schedulingv1.SchemeGroupVersion.WithResource("priorityclasses"),
+}
+
+// Simulate runs consecutive descheduling cycles of the policy over the objects of a snapshot
+// directory instead of a cluster. Between the cycles each evicted pod with an owner is replaced
+// by a new pod scheduled to one of the nodes, as its controller and the scheduler would do.
+// The simulation stops at the first cycle evicting no pod.
+func Simulate(ctx context.Context, rs *options.DeschedulerServer, snapshotDir string, cycles int) (*simulation.Result, error) {
+	if cycles < 1 {
+		return nil, fmt.Errorf("the number of cycles must be at least 1, got %d", cycles)
+	}
+	objects, err := snapshot.Load(snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	deschedulerPolicy, err := LoadPolicyConfig(rs.PolicyConfigFile, fakeclientset.NewSimpleClientset(objects...), pluginregistry.PluginRegistry)
+	if err != nil {
+		return nil, err
+	}
+	if deschedulerPolicy == nil {
+		return nil, fmt.Errorf("deschedulerPolicy is nil")
+	}
+
+	var result *simulation.Result
+	var cycleErr error
+	err = runOverObjects(ctx, rs, objects, deschedulerPolicy, func(ctx context.Context, d *descheduler, nodes []*v1.Node) error {
+		result, cycleErr = d.simulateCycles(ctx, nodes, cycles)
+		return cycleErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cycleErr != nil {
+		return nil, cycleErr
+	}
+	if result == nil {
+		return nil, fmt.Errorf("the descheduling cycle did not run")
+	}
+	return result, nil
+}
+
+// runOverObjects runs the cycle with the policy in the dry run mode over a fake cluster of the objects
+func runOverObjects(ctx context.Context, rs *options.DeschedulerServer, objects []runtime.Object, deschedulerPolicy *api.DeschedulerPolicy, cycle cycleFunc) error {
+	rs.Client = fakeclientset.NewSimpleClientset(objects...)
+	rs.EventClient = fakeclientset.NewSimpleClientset()
+	rs.DryRun = true
+	rs.DeschedulingInterval = 0
+	return runDeschedulerStrategies(ctx, rs, withoutSchedules(deschedulerPolicy), policy.SchemeGroupVersion.String(), cycle)
+}
+
+func (d *descheduler) simulateCycles(ctx context.Context, nodes []*v1.Node, cycles int) (*simulation.Result, error) {
+	result := &simulation.Result{}
+	tracker := simulation.NewTracker()
+	for i := 1; i <= cycles; i++ {
+		if err := d.runDeschedulerLoop(ctx, nodes); err != nil {
+			return nil, err
+		}
+		cycle, err := d.replaceEvictedPods(ctx, nodes, i, tracker)
+		if err != nil {
+			return nil, err
+		}
+		result.Cycles = append(result.Cycles, *cycle)
+		if len(cycle.Evictions) == 0 {
+			result.Converged = true
+			break
+		}
+	}
+	result.MovedPods = tracker.MovedPods()
+	return result, nil
+}
+
+// replaceEvictedPods deletes the pods evicted in the last dry run cycle from the simulated cluster
+// and creates their replacements, so the next cycle runs over the cluster the evictions lead to
+func (d *descheduler) replaceEvictedPods(ctx context.Context, nodes []*v1.Node, cycleNumber int, tracker *simulation.Tracker) (*simulation.Cycle, error) {
+	podLister := d.ir.sharedInformerFactory.Core().V1().Pods().Lister()
+	pods, err := podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	cluster := simulation.NewCluster(nodes, pods)
+	cycle := &simulation.Cycle{Evictions: d.evictionPlan(nodes).Entries}
+
+	var deleted, created []*v1.Pod
+	for idx := range cycle.Evictions {
+		entry := &cycle.Evictions[idx]
+		entry.TargetNode = ""
+		pod, err := podLister.Pods(entry.Namespace).Get(entry.Name)
+		if err != nil || pod.UID != entry.UID {
+			klog.V(1).InfoS("Evicted pod not found in the simulated cluster", "pod", klog.KRef(entry.Namespace, entry.Name))
+			continue
+		}
+		cluster.Remove(pod)
+		if err := d.rs.Client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil {
+			return nil, fmt.Errorf("unable to delete the evicted pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+		deleted = append(deleted, pod)
+
+		if !simulation.IsReplaced(pod) {
+			tracker.Moved(pod.Namespace, pod.Name, entry.Node, "", "")
+			continue
+		}
+		replacement := simulation.Replacement(pod, fmt.Sprintf("c%d-%d", cycleNumber, idx))
+		if node := cluster.Schedule(replacement); node != nil {
+			entry.TargetNode = node.Name
+		} else {
+			cycle.Unschedulable = append(cycle.Unschedulable, replacement.Namespace+"/"+replacement.Name)
+		}
+		if _, err := d.rs.Client.CoreV1().Pods(replacement.Namespace).Create(ctx, replacement, metav1.CreateOptions{}); err != nil {
+			return nil, fmt.Errorf("unable to create the replacement pod %s/%s: %v", replacement.Namespace, replacement.Name, err)
+		}
+		created = append(created, replacement)
+		tracker.Moved(pod.Namespace, pod.Name, entry.Node, replacement.Name, entry.TargetNode)
+	}
+
+	// the next cycle copies the cluster from the informers, wait for them to observe the changes
+	err = wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 30*time.Second, true, func(ctx context.Context) (bool, error) {
+		for _, pod := range deleted {
+			if current, err := podLister.Pods(pod.Namespace).Get(pod.Name); err == nil && current.UID == pod.UID {
+				return false, nil
+			}
+		}
+		for _, pod := range created {
+			if _, err := podLister.Pods(pod.Namespace).Get(pod.Name); err != nil {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("the simulated cluster did not observe the replaced pods: %v", err)
+	}
+
+	cycle.Utilization = cluster.Utilization()
+	klog.V(1).InfoS("Simulated descheduling cycle", "cycle", cycleNumber, "evictions", len(cycle.Evictions), "unschedulable", len(cycle.Unschedulable))
+	return cycle, nil
+}
+
+// TakeSnapshot writes the objects the descheduler is informed of when running
+// the policy into the directory, so the policy can be simulated offline.
+// Resources known only to the dynamic client (e.g. custom resources) are not exported.
+func TakeSnapshot(ctx context.Context, rs *options.DeschedulerServer, snapshotDir string) error {
+	deschedulerPolicy, _, err := setup(ctx, rs)
+	if err != nil {
+		return err
+	}
+
+	objects, err := clusterObjects(ctx, rs.Client, append(informedResources(deschedulerPolicy), snapshotResources...))
+	if err != nil {
+		return err
+	}
+	if err := snapshot.Write(snapshotDir, objects); err != nil {
+		return err
+	}
+	klog.InfoS("Snapshot written", "directory", snapshotDir, "objects", len(objects))
+	return nil
+}
+
+// clusterObjects lists the objects of the resources known to the kubernetes client set.
+// Other resources (e.g. custom resources) are logged and left out.
+func clusterObjects(ctx context.Context, client clientset.Interface, resources []schema.GroupVersionResource) ([]runtime.Object, error) {
+	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithTransform(trimManagedFields))
+	ir := newInformerResources(sharedInformerFactory, nil, nil)
+	for _, resource := range resources {
+		if err := ir.Uses(resource); err != nil {
+			klog.InfoS("Resource left out of the snapshot", "resource", resource, "err", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	var objects []runtime.Object
+	for resource, informer := range ir.resourceToInformer {
+		objs, err := informer.Lister().List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s: %v", resource, err)
+		}
+		objects = append(objects, objs...)
+	}
+	return objects, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler/snapshot"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodetaints"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestSimulate(t *testing.T) {
+	initPluginRegistry()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	objects := []runtime.Object{node1, node2}
+	for i := 0; i < 2; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%v", i), 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		}))
+	}
+	objects = append(objects, test.BuildTestPod("p2", 100, 0, node2.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+	}))
+
+	dir := t.TempDir()
+	snapshotDir := filepath.Join(dir, "snapshot")
+	if err := snapshot.Write(snapshotDir, objects); err != nil {
+		t.Fatalf("Unable to write the snapshot: %v", err)
+	}
+	policyFile := filepath.Join(dir, "policy.yaml")
+	policy := `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: Profile
+    pluginConfig:
+      - name: "RemovePodsViolatingNodeTaints"
+      - name: "DefaultEvictor"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsViolatingNodeTaints"
+`
+	if err := os.WriteFile(policyFile, []byte(policy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.DefaultFeatureGates = initFeatureGates()
+	rs.PolicyConfigFile = policyFile
+	result, err := Simulate(context.Background(), rs, snapshotDir, 5)
+	if err != nil {
+		t.Fatalf("Unable to simulate the policy: %v", err)
+	}
+
+	// the replacements are scheduled to the untainted node, so the second cycle evicts nothing
+	if len(result.Cycles) != 2 || !result.Converged {
+		t.Fatalf("Expected the simulation to converge in 2 cycles, got %d cycles, converged: %v", len(result.Cycles), result.Converged)
+	}
+	evictions := result.Cycles[0].Evictions
+	sort.Slice(evictions, func(i, j int) bool {
+		return evictions[i].Name < evictions[j].Name
+	})
+	var evicted []string
+	for _, entry := range evictions {
+		evicted = append(evicted, entry.Name)
+		if entry.Node != node1.Name || entry.TargetNode != node2.Name || entry.Plugin != removepodsviolatingnodetaints.PluginName {
+			t.Errorf("Unexpected eviction %+v", entry)
+		}
+	}
+	if diff := cmp.Diff([]string{"p0", "p1"}, evicted); diff != "" {
+		t.Errorf("Unexpected evicted pods (-want +got):\n%s", diff)
+	}
+	if len(result.Cycles[1].Evictions) != 0 {
+		t.Errorf("Expected no eviction in the second cycle, got %+v", result.Cycles[1].Evictions)
+	}
+	// 3 pods of 100m cpu on a node of 2000m cpu
+	if usage := result.Cycles[0].Utilization[node2.Name][v1.ResourceCPU]; usage != 15 {
+		t.Errorf("Expected 15%% of the cpu of %s requested, got %v", node2.Name, usage)
+	}
+	if usage := result.Cycles[0].Utilization[node1.Name][v1.ResourceCPU]; usage != 0 {
+		t.Errorf("Expected no cpu of %s requested, got %v", node1.Name, usage)
+	}
+	if len(result.MovedPods) != 0 {
+		t.Errorf("Expected no pod moved more than once, got %+v", result.MovedPods)
+	}
+}
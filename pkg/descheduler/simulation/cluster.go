@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulation replaces the pods evicted in a simulated descheduling cycle
+// the way their controllers and the scheduler would, so the next simulated cycle
+// runs over a cluster with the replacement pods in place.
+package simulation
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+var utilizationResources = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, v1.ResourcePods}
+
+// Cluster holds the nodes and the pods assigned to them while the evicted pods are being replaced
+type Cluster struct {
+	nodes      []*v1.Node
+	podsByNode map[string][]*v1.Pod
+}
+
+// NewCluster returns a cluster of the nodes with the pods assigned to them.
+// Pods which terminated are left out.
+func NewCluster(nodes []*v1.Node, pods []*v1.Pod) *Cluster {
+	c := &Cluster{
+		nodes:      slices.Clone(nodes),
+		podsByNode: map[string][]*v1.Pod{},
+	}
+	// keep the scheduling deterministic
+	sort.Slice(c.nodes, func(i, j int) bool {
+		return c.nodes[i].Name < c.nodes[j].Name
+	})
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		c.Add(pod)
+	}
+	return c
+}
+
+// GetPodsAssignedToNode lists the pods assigned to the node which pass the filter
+func (c *Cluster) GetPodsAssignedToNode(nodeName string, filter podutil.FilterFunc) ([]*v1.Pod, error) {
+	var pods []*v1.Pod
+	for _, pod := range c.podsByNode[nodeName] {
+		if filter == nil || filter(pod) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// Add assigns the pod to its node. Pods which are not scheduled are ignored.
+func (c *Cluster) Add(pod *v1.Pod) {
+	if pod.Spec.NodeName == "" {
+		return
+	}
+	c.podsByNode[pod.Spec.NodeName] = append(c.podsByNode[pod.Spec.NodeName], pod)
+}
+
+// Remove unassigns the pod from its node
+func (c *Cluster) Remove(pod *v1.Pod) {
+	pods := c.podsByNode[pod.Spec.NodeName]
+	for idx, p := range pods {
+		if p.UID == pod.UID {
+			c.podsByNode[pod.Spec.NodeName] = append(pods[:idx:idx], pods[idx+1:]...)
+			return
+		}
+	}
+}
+
+// Schedule picks a node for the pod and assigns the pod to it. Of the nodes the pod fits on
+// the node the pod gives the highest preferred node affinity weight to is picked,
+// ties are broken by the lowest requested cpu and memory, then by the node name.
+// The scheduled pod is considered running and ready right away.
+// Returns nil and leaves the pod pending when the pod does not fit any node.
+func (c *Cluster) Schedule(pod *v1.Pod) *v1.Node {
+	var best *v1.Node
+	var bestWeight int32
+	var bestUsage float64
+	for _, node := range c.nodes {
+		if err := nodeutil.NodeFit(c.GetPodsAssignedToNode, pod, node); err != nil {
+			continue
+		}
+		weight := nodeutil.GetNodeWeightGivenPodPreferredAffinity(pod, node)
+		usage := c.requestedFraction(node)
+		if best == nil || weight > bestWeight || (weight == bestWeight && usage < bestUsage) {
+			best, bestWeight, bestUsage = node, weight, usage
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	pod.Spec.NodeName = best.Name
+	pod.Status = v1.PodStatus{
+		Phase:      v1.PodRunning,
+		Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+	}
+	c.Add(pod)
+	return best
+}
+
+// requestedFraction returns the sum of the requested fractions of the allocatable cpu and memory of the node
+func (c *Cluster) requestedFraction(node *v1.Node) float64 {
+	var fraction float64
+	for resourceName, percentage := range c.NodeUtilization(node) {
+		if resourceName == v1.ResourceCPU || resourceName == v1.ResourceMemory {
+			fraction += float64(percentage)
+		}
+	}
+	return fraction
+}
+
+// NodeUtilization returns the cpu, memory and pods requested by the pods of the node
+// as percentages of the allocatable resources of the node
+func (c *Cluster) NodeUtilization(node *v1.Node) api.ResourceThresholds {
+	usage, err := nodeutil.NodeUtilization(c.podsByNode[node.Name], utilizationResources, func(pod *v1.Pod) (v1.ResourceList, error) {
+		req, _ := utils.PodRequestsAndLimits(pod)
+		return req, nil
+	})
+	if err != nil {
+		return api.ResourceThresholds{}
+	}
+	allocatable := api.ReferencedResourceList{}
+	for _, resourceName := range utilizationResources {
+		if quantity, ok := node.Status.Allocatable[resourceName]; ok {
+			allocatable[resourceName] = &quantity
+		}
+	}
+	return nodeutilization.ResourceUsageToResourceThreshold(usage, allocatable)
+}
+
+// Utilization returns the utilization of all the nodes keyed by the node name
+func (c *Cluster) Utilization() map[string]api.ResourceThresholds {
+	utilization := map[string]api.ResourceThresholds{}
+	for _, node := range c.nodes {
+		utilization[node.Name] = c.NodeUtilization(node)
+	}
+	return utilization
+}
+
+// IsReplaced tells whether the pod is replaced once it is evicted, i.e. whether it has an owner
+func IsReplaced(pod *v1.Pod) bool {
+	return len(podutil.OwnerRef(pod)) > 0
+}
+
+// Replacement returns the pod a controller would create in place of the evicted pod.
+// The replacement is not scheduled yet; its name gets the suffix appended to the
+// generate name of the evicted pod, or to its name when the pod has no generate name.
+func Replacement(pod *v1.Pod, suffix string) *v1.Pod {
+	replacement := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         pod.Namespace,
+			GenerateName:      pod.GenerateName,
+			Labels:            pod.Labels,
+			Annotations:       pod.Annotations,
+			OwnerReferences:   pod.OwnerReferences,
+			UID:               types.UID(uuid.NewUUID()),
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec:   *pod.Spec.DeepCopy(),
+		Status: v1.PodStatus{Phase: v1.PodPending},
+	}
+	if pod.GenerateName != "" {
+		replacement.Name = pod.GenerateName + suffix
+	} else {
+		replacement.Name = fmt.Sprintf("%s-%s", pod.Name, suffix)
+	}
+	replacement.Spec.NodeName = ""
+	return replacement
+}
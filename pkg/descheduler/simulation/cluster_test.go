@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func preferZone(zone string) func(*v1.Pod) {
+	return func(pod *v1.Pod) {
+		pod.Spec.Affinity = &v1.Affinity{
+			NodeAffinity: &v1.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{
+					{
+						Weight: 10,
+						Preference: v1.NodeSelectorTerm{
+							MatchExpressions: []v1.NodeSelectorRequirement{
+								{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{zone}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	inZone := func(zone string) func(*v1.Node) {
+		return func(node *v1.Node) {
+			node.Labels["zone"] = zone
+		}
+	}
+	nodes := []*v1.Node{
+		test.BuildTestNode("n3", 1000, 3000, 10, inZone("b")),
+		test.BuildTestNode("n2", 1000, 3000, 10, inZone("a")),
+		test.BuildTestNode("n1", 1000, 3000, 10, inZone("a")),
+	}
+
+	tests := []struct {
+		description  string
+		pods         []*v1.Pod
+		pod          *v1.Pod
+		expectedNode string
+	}{
+		{
+			description:  "ties are broken by the node name",
+			pod:          test.BuildTestPod("p", 100, 0, "", nil),
+			expectedNode: "n1",
+		},
+		{
+			description: "least requested node",
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 500, 0, "n1", nil),
+				test.BuildTestPod("p2", 200, 0, "n2", nil),
+				test.BuildTestPod("p3", 300, 0, "n3", nil),
+			},
+			pod:          test.BuildTestPod("p", 100, 0, "", nil),
+			expectedNode: "n2",
+		},
+		{
+			description: "preferred node affinity first",
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 500, 0, "n1", nil),
+				test.BuildTestPod("p2", 200, 0, "n2", nil),
+			},
+			pod:          test.BuildTestPod("p", 100, 0, "", preferZone("b")),
+			expectedNode: "n3",
+		},
+		{
+			description: "preferred node without enough resources",
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 1000, 0, "n3", nil),
+			},
+			pod:          test.BuildTestPod("p", 100, 0, "", preferZone("b")),
+			expectedNode: "n1",
+		},
+		{
+			description:  "no node fits",
+			pod:          test.BuildTestPod("p", 2000, 0, "", nil),
+			expectedNode: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			cluster := NewCluster(nodes, tc.pods)
+			node := cluster.Schedule(tc.pod)
+			nodeName := ""
+			if node != nil {
+				nodeName = node.Name
+			}
+			if nodeName != tc.expectedNode {
+				t.Fatalf("Expected the pod scheduled to %q, got %q", tc.expectedNode, nodeName)
+			}
+			if tc.pod.Spec.NodeName != tc.expectedNode {
+				t.Errorf("Expected the pod assigned to %q, got %q", tc.expectedNode, tc.pod.Spec.NodeName)
+			}
+			if nodeName == "" {
+				return
+			}
+			pods, _ := cluster.GetPodsAssignedToNode(nodeName, nil)
+			if pods[len(pods)-1] != tc.pod {
+				t.Errorf("Expected the pod to be assigned to %s in the cluster", nodeName)
+			}
+		})
+	}
+}
+
+func TestReplacement(t *testing.T) {
+	pod := test.BuildTestPod("web-1", 100, 0, "n1", test.SetRSOwnerRef)
+	replacement := Replacement(pod, "c1-0")
+	if replacement.Name != "web-1-c1-0" || replacement.UID == pod.UID || replacement.Spec.NodeName != "" {
+		t.Errorf("Unexpected replacement %s/%s (%s) on node %q", replacement.Namespace, replacement.Name, replacement.UID, replacement.Spec.NodeName)
+	}
+	if !IsReplaced(pod) {
+		t.Errorf("Expected a pod with an owner to be replaced")
+	}
+
+	pod.GenerateName = "web-"
+	if replacement := Replacement(pod, "c1-0"); replacement.Name != "web-c1-0" {
+		t.Errorf("Expected the replacement name to use the generate name, got %s", replacement.Name)
+	}
+	if IsReplaced(test.BuildTestPod("bare", 100, 0, "n1", nil)) {
+		t.Errorf("Expected a pod without an owner not to be replaced")
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// PolicyDiff is the difference of the descheduling cycles of two policies run over the same cluster state
+type PolicyDiff struct {
+	// OnlyOld are the evictions of the pods only the old policy evicts
+	OnlyOld []plan.Entry `json:"onlyOld"`
+	// OnlyNew are the evictions of the pods only the new policy evicts
+	OnlyNew []plan.Entry `json:"onlyNew"`
+	// Both is the number of pods both policies evict
+	Both int `json:"both"`
+	// NodeClassification lists the nodes the plugins of the policies classify differently
+	NodeClassification []NodeClassificationDiff `json:"nodeClassification,omitempty"`
+}
+
+// NodeClassificationDiff is a node the plugins of the two policies classify differently
+type NodeClassificationDiff struct {
+	Node string `json:"node"`
+	// Old and New are the classes of the node as plugin/class, e.g. LowNodeUtilization/underutilized
+	Old []string `json:"old"`
+	New []string `json:"new"`
+}
+
+// Diff compares the reports of the descheduling cycles of the old and the new policy.
+// Pods are matched by their namespace, name and UID, nodes by their name, and classes by the plugin
+// name and the class name regardless of the profile, as the profiles of the policies may be named differently.
+func Diff(oldReports, newReports []*frameworktypes.CycleReport) *PolicyDiff {
+	diff := &PolicyDiff{OnlyOld: []plan.Entry{}, OnlyNew: []plan.Entry{}}
+
+	oldEntries := plan.EntriesFromReports(oldReports)
+	newEntries := plan.EntriesFromReports(newReports)
+	oldPods, newPods := evictedPods(oldEntries), evictedPods(newEntries)
+	for _, entry := range oldEntries {
+		if !newPods.Has(entryKey(entry)) {
+			diff.OnlyOld = append(diff.OnlyOld, entry)
+		}
+	}
+	for _, entry := range newEntries {
+		if !oldPods.Has(entryKey(entry)) {
+			diff.OnlyNew = append(diff.OnlyNew, entry)
+		}
+	}
+	diff.Both = oldPods.Intersection(newPods).Len()
+	sortEntries(diff.OnlyOld)
+	sortEntries(diff.OnlyNew)
+
+	oldClasses, newClasses := nodeClasses(oldReports), nodeClasses(newReports)
+	nodes := sets.KeySet(oldClasses).Union(sets.KeySet(newClasses))
+	for _, node := range sets.List(nodes) {
+		oldNodeClasses, newNodeClasses := sets.List(oldClasses[node]), sets.List(newClasses[node])
+		if !slices.Equal(oldNodeClasses, newNodeClasses) {
+			diff.NodeClassification = append(diff.NodeClassification, NodeClassificationDiff{Node: node, Old: oldNodeClasses, New: newNodeClasses})
+		}
+	}
+	return diff
+}
+
+// Encode writes the diff as indented JSON
+func (d *PolicyDiff) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(d)
+}
+
+func entryKey(entry plan.Entry) string {
+	return entry.Namespace + "/" + entry.Name + "/" + string(entry.UID)
+}
+
+// evictedPods returns the keys of the evicted pods, a pod evicted by multiple profiles is counted once
+func evictedPods(entries []plan.Entry) sets.Set[string] {
+	pods := sets.New[string]()
+	for _, entry := range entries {
+		pods.Insert(entryKey(entry))
+	}
+	return pods
+}
+
+func sortEntries(entries []plan.Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Namespace != entries[j].Namespace {
+			return entries[i].Namespace < entries[j].Namespace
+		}
+		return entries[i].Name < entries[j].Name
+	})
+}
+
+// nodeClasses returns the plugin/class pairs each node got assigned to by the plugins
+func nodeClasses(reports []*frameworktypes.CycleReport) map[string]sets.Set[string] {
+	classes := map[string]sets.Set[string]{}
+	for _, report := range reports {
+		for _, pluginReport := range report.Plugins {
+			for class, nodes := range pluginReport.Status.NodeClassification {
+				for _, node := range nodes {
+					if classes[node] == nil {
+						classes[node] = sets.New[string]()
+					}
+					classes[node].Insert(pluginReport.Plugin + "/" + class)
+				}
+			}
+		}
+	}
+	return classes
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+func evictedBy(profile, plugin string, classification map[string][]string, pods ...string) *frameworktypes.CycleReport {
+	status := &frameworktypes.Status{NodeClassification: classification}
+	for _, pod := range pods {
+		status.Evicted = append(status.Evicted, frameworktypes.PodResult{Namespace: "default", Name: pod, UID: types.UID("uid-" + pod), Node: "n1"})
+	}
+	return &frameworktypes.CycleReport{
+		Profile: profile,
+		Plugins: []frameworktypes.PluginReport{{Plugin: plugin, Status: status}},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	oldReports := []*frameworktypes.CycleReport{
+		evictedBy("Taints", "RemovePodsViolatingNodeTaints", nil, "p3", "p1"),
+		evictedBy("Balance", "LowNodeUtilization", map[string][]string{"underutilized": {"n2"}, "overutilized": {"n1"}}, "p2"),
+	}
+	newReports := []*frameworktypes.CycleReport{
+		// the same pod evicted by two profiles is counted once
+		evictedBy("Taints", "RemovePodsViolatingNodeTaints", nil, "p2"),
+		evictedBy("Default", "LowNodeUtilization", map[string][]string{"underutilized": {"n2", "n3"}, "overutilized": {"n1"}}, "p2", "p4"),
+	}
+
+	diff := Diff(oldReports, newReports)
+
+	entry := func(pod, profile, plugin string) plan.Entry {
+		return plan.Entry{Namespace: "default", Name: pod, UID: types.UID("uid-" + pod), Node: "n1", Profile: profile, Plugin: plugin}
+	}
+	expected := &PolicyDiff{
+		OnlyOld: []plan.Entry{
+			entry("p1", "Taints", "RemovePodsViolatingNodeTaints"),
+			entry("p3", "Taints", "RemovePodsViolatingNodeTaints"),
+		},
+		OnlyNew: []plan.Entry{
+			entry("p4", "Default", "LowNodeUtilization"),
+		},
+		Both: 1,
+		NodeClassification: []NodeClassificationDiff{
+			{Node: "n3", Old: []string{}, New: []string{"LowNodeUtilization/underutilized"}},
+		},
+	}
+	if diff := cmp.Diff(expected, diff); diff != "" {
+		t.Errorf("Unexpected diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// Explanation tells why the profiles of a policy would or would not evict a pod
+type Explanation struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Node      string `json:"node"`
+	// Profiles are the explanations of each profile of the policy
+	Profiles []ProfileExplanation `json:"profiles"`
+	// NodeFit is the result of checking whether the pod fits each node
+	NodeFit []NodeFit `json:"nodeFit"`
+}
+
+// ProfileExplanation tells why a profile would or would not evict a pod
+type ProfileExplanation struct {
+	Profile string `json:"profile"`
+	// NodeSelected is false when the node of the pod does not match the node selector of the profile
+	NodeSelected bool `json:"nodeSelected"`
+	// Filter and PreEvictionFilter are the verdicts of the evictor plugins of the profile
+	Filter            []Verdict `json:"filter"`
+	PreEvictionFilter []Verdict `json:"preEvictionFilter"`
+	// Plugins tell whether each enabled deschedule and balance plugin selects the pod
+	Plugins []PluginExplanation `json:"plugins"`
+}
+
+// Verdict is the verdict of an evictor plugin on a pod
+type Verdict struct {
+	Plugin  string `json:"plugin"`
+	Allowed bool   `json:"allowed"`
+	// Reasons the plugin rejects the pod for, as code: message
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// PluginExplanation tells whether a deschedule or balance plugin selects a pod
+type PluginExplanation struct {
+	Plugin         string                        `json:"plugin"`
+	ExtensionPoint frameworktypes.ExtensionPoint `json:"extensionPoint"`
+	// Selected is true when the plugin evicts the pod
+	Selected bool `json:"selected"`
+	// Reason of the eviction, or why the pod was not evicted
+	Reason string `json:"reason"`
+}
+
+// NodeFit tells whether a pod fits a node
+type NodeFit struct {
+	Node string `json:"node"`
+	Fits bool   `json:"fits"`
+	// Reason the pod does not fit the node
+	Reason string `json:"reason,omitempty"`
+}
+
+// Encode writes the explanation as indented JSON
+func (e *Explanation) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(e)
+}
+
+// Verdicts converts the verdicts of a filter chain
+func Verdicts(verdicts frameworktypes.FilterVerdicts) []Verdict {
+	converted := []Verdict{}
+	for _, verdict := range verdicts {
+		v := Verdict{Plugin: verdict.PluginName, Allowed: verdict.Allowed()}
+		for _, reason := range verdict.Reasons {
+			v.Reasons = append(v.Reasons, fmt.Sprintf("%s: %s", reason.Code, reason.Message))
+		}
+		converted = append(converted, v)
+	}
+	return converted
+}
+
+// PluginSelection tells from the reports of a descheduling cycle whether the plugin evicted the pod
+func PluginSelection(plugin string, extensionPoint frameworktypes.ExtensionPoint, namespace, name string, reports []*frameworktypes.CycleReport) PluginExplanation {
+	explanation := PluginExplanation{Plugin: plugin, ExtensionPoint: extensionPoint, Reason: "the plugin did not run"}
+	for _, report := range reports {
+		for _, pluginReport := range report.Plugins {
+			if pluginReport.Plugin != plugin || pluginReport.ExtensionPoint != extensionPoint {
+				continue
+			}
+			status := pluginReport.Status
+			if result := findPod(status.Evicted, namespace, name); result != nil {
+				explanation.Selected = true
+				explanation.Reason = result.Reason
+				if explanation.Reason == "" {
+					explanation.Reason = "evicted"
+				}
+				return explanation
+			}
+			if result := findPod(status.Skipped, namespace, name); result != nil {
+				explanation.Reason = "selected, but not evicted: " + result.Reason
+				return explanation
+			}
+			switch {
+			case status.Err != nil:
+				explanation.Reason = fmt.Sprintf("not selected, the plugin failed: %v", status.Err)
+			case status.Code != "":
+				explanation.Reason = fmt.Sprintf("not selected, the plugin finished with %s", status.Code)
+			default:
+				explanation.Reason = "not selected"
+			}
+			return explanation
+		}
+	}
+	return explanation
+}
+
+func findPod(results []frameworktypes.PodResult, namespace, name string) *frameworktypes.PodResult {
+	for idx := range results {
+		if results[idx].Namespace == namespace && results[idx].Name == name {
+			return &results[idx]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+func TestVerdicts(t *testing.T) {
+	verdicts := frameworktypes.FilterVerdicts{
+		{PluginName: "DefaultEvictor", Reasons: []frameworktypes.FilterReason{
+			{Code: "NoOwnerReferences", Message: "pod does not have any ownerRefs"},
+			{Code: "LocalStorage", Message: "pod has local storage"},
+		}},
+		{PluginName: "Custom"},
+	}
+	expected := []Verdict{
+		{Plugin: "DefaultEvictor", Allowed: false, Reasons: []string{"NoOwnerReferences: pod does not have any ownerRefs", "LocalStorage: pod has local storage"}},
+		{Plugin: "Custom", Allowed: true},
+	}
+	if diff := cmp.Diff(expected, Verdicts(verdicts)); diff != "" {
+		t.Errorf("Unexpected verdicts (-want +got):\n%s", diff)
+	}
+}
+
+func TestPluginSelection(t *testing.T) {
+	report := func(status *frameworktypes.Status) []*frameworktypes.CycleReport {
+		return []*frameworktypes.CycleReport{{
+			Profile: "Default",
+			Plugins: []frameworktypes.PluginReport{{Plugin: "RemovePodsViolatingNodeTaints", ExtensionPoint: frameworktypes.DescheduleExtensionPoint, Status: status}},
+		}}
+	}
+	pod := func(name, reason string) frameworktypes.PodResult {
+		return frameworktypes.PodResult{Namespace: "default", Name: name, Node: "n1", Reason: reason}
+	}
+
+	tests := []struct {
+		description    string
+		extensionPoint frameworktypes.ExtensionPoint
+		reports        []*frameworktypes.CycleReport
+		expected       PluginExplanation
+	}{
+		{
+			description:    "evicted pod",
+			extensionPoint: frameworktypes.DescheduleExtensionPoint,
+			reports:        report(&frameworktypes.Status{Evicted: []frameworktypes.PodResult{pod("p2", ""), pod("p1", "NodeTaint")}}),
+			expected:       PluginExplanation{Selected: true, Reason: "NodeTaint"},
+		},
+		{
+			description:    "skipped pod",
+			extensionPoint: frameworktypes.DescheduleExtensionPoint,
+			reports:        report(&frameworktypes.Status{Skipped: []frameworktypes.PodResult{pod("p1", "eviction limit reached")}}),
+			expected:       PluginExplanation{Reason: "selected, but not evicted: eviction limit reached"},
+		},
+		{
+			description:    "pod not selected",
+			extensionPoint: frameworktypes.DescheduleExtensionPoint,
+			reports:        report(&frameworktypes.Status{Evicted: []frameworktypes.PodResult{pod("p2", "")}}),
+			expected:       PluginExplanation{Reason: "not selected"},
+		},
+		{
+			description:    "failed plugin",
+			extensionPoint: frameworktypes.DescheduleExtensionPoint,
+			reports:        report(&frameworktypes.Status{Err: errors.New("boom")}),
+			expected:       PluginExplanation{Reason: "not selected, the plugin failed: boom"},
+		},
+		{
+			description:    "plugin not run at the extension point",
+			extensionPoint: frameworktypes.BalanceExtensionPoint,
+			reports:        report(&frameworktypes.Status{Evicted: []frameworktypes.PodResult{pod("p1", "")}}),
+			expected:       PluginExplanation{Reason: "the plugin did not run"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			tc.expected.Plugin = "RemovePodsViolatingNodeTaints"
+			tc.expected.ExtensionPoint = tc.extensionPoint
+			got := PluginSelection("RemovePodsViolatingNodeTaints", tc.extensionPoint, "default", "p1", tc.reports)
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected explanation (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
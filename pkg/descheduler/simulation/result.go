@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
+)
+
+// Result is the outcome of simulating consecutive descheduling cycles
+type Result struct {
+	// Cycles are the simulated cycles in order. The simulation stops at the first cycle evicting no pod.
+	Cycles []Cycle `json:"cycles"`
+	// Converged is true when the last simulated cycle evicted no pod
+	Converged bool `json:"converged"`
+	// MovedPods are the pods which were evicted more than once, their replacements included
+	MovedPods []MovedPod `json:"movedPods,omitempty"`
+}
+
+// Cycle is the outcome of a single simulated descheduling cycle
+type Cycle struct {
+	// Evictions made in the cycle. The target node is the node the replacement pod was scheduled to,
+	// it is empty when the pod has no owner to replace it or the replacement does not fit any node.
+	Evictions []plan.Entry `json:"evictions"`
+	// Unschedulable lists the replacement pods no node fits, as namespace/name
+	Unschedulable []string `json:"unschedulable,omitempty"`
+	// Utilization is the resources requested on each node, as percentages of the allocatable
+	// resources, once the evicted pods were replaced
+	Utilization map[string]api.ResourceThresholds `json:"utilization"`
+}
+
+// MovedPod is a pod evicted more than once over the simulated cycles
+type MovedPod struct {
+	// Namespace and Name of the pod in the snapshot
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Nodes the pod and its replacements ran on, in order
+	Nodes []string `json:"nodes"`
+}
+
+// Encode writes the result as indented JSON
+func (r *Result) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+type podKey struct {
+	namespace string
+	name      string
+}
+
+// Tracker follows the pods of the snapshot through their replacements
+type Tracker struct {
+	// origins maps the replacement pods to the pods of the snapshot they replace
+	origins map[podKey]podKey
+	// nodes lists the nodes each pod of the snapshot and its replacements ran on
+	nodes map[podKey][]string
+	// evictions counts the evictions of each pod of the snapshot and its replacements
+	evictions map[podKey]int
+}
+
+// NewTracker returns an empty tracker
+func NewTracker() *Tracker {
+	return &Tracker{
+		origins:   map[podKey]podKey{},
+		nodes:     map[podKey][]string{},
+		evictions: map[podKey]int{},
+	}
+}
+
+// Moved records the eviction of the pod from the node and its replacement scheduled to the target node.
+// The replacement name is empty when the pod is not replaced, the target node is empty when the replacement is pending.
+func (t *Tracker) Moved(namespace, name, node, replacement, targetNode string) {
+	key := podKey{namespace: namespace, name: name}
+	origin, ok := t.origins[key]
+	if !ok {
+		origin = key
+		t.nodes[origin] = []string{node}
+	}
+	delete(t.origins, key)
+	t.evictions[origin]++
+	if replacement == "" {
+		return
+	}
+	t.origins[podKey{namespace: namespace, name: replacement}] = origin
+	t.nodes[origin] = append(t.nodes[origin], targetNode)
+}
+
+// MovedPods returns the pods evicted more than once, sorted by namespace and name
+func (t *Tracker) MovedPods() []MovedPod {
+	var moved []MovedPod
+	for key, evictions := range t.evictions {
+		if evictions > 1 {
+			moved = append(moved, MovedPod{Namespace: key.namespace, Name: key.name, Nodes: t.nodes[key]})
+		}
+	}
+	sort.Slice(moved, func(i, j int) bool {
+		if moved[i].Namespace != moved[j].Namespace {
+			return moved[i].Namespace < moved[j].Namespace
+		}
+		return moved[i].Name < moved[j].Name
+	})
+	return moved
+}
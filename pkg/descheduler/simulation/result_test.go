@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker()
+	// cycle 1
+	tracker.Moved("default", "p1", "n1", "p1-c1-0", "n2")
+	tracker.Moved("default", "p2", "n1", "p2-c1-1", "n2")
+	tracker.Moved("default", "bare", "n1", "", "")
+	// cycle 2, the replacement of p1 is moved back
+	tracker.Moved("default", "p1-c1-0", "n2", "p1-c1-0-c2-0", "n1")
+	// cycle 3, and once more to a node it does not fit
+	tracker.Moved("default", "p1-c1-0-c2-0", "n1", "p1-c1-0-c2-0-c3-0", "")
+
+	expected := []MovedPod{
+		{Namespace: "default", Name: "p1", Nodes: []string{"n1", "n2", "n1", ""}},
+	}
+	if diff := cmp.Diff(expected, tracker.MovedPods()); diff != "" {
+		t.Errorf("Unexpected moved pods (-want +got):\n%s", diff)
+	}
+}
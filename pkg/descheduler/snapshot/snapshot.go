@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot reads and writes directories of cluster objects the descheduler
+// can be run over without an API server.
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+// Load reads the objects of all the .yaml, .yml and .json files in the directory.
+// A file may hold multiple YAML documents or JSON objects, and lists of objects
+// (e.g. the output of kubectl get -o yaml).
+func Load(dir string) ([]runtime.Object, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the snapshot: %v", err)
+	}
+	var objects []runtime.Object
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(file.Name())) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %v", path, err)
+		}
+		fileObjects, err := decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %v", path, err)
+		}
+		objects = append(objects, fileObjects...)
+	}
+	return objects, nil
+}
+
+func decode(data []byte) ([]runtime.Object, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	var objects []runtime.Object
+	for {
+		raw := runtime.RawExtension{}
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+			return nil, err
+		}
+		raw.Raw = bytes.TrimSpace(raw.Raw)
+		if len(raw.Raw) == 0 || bytes.Equal(raw.Raw, []byte("null")) {
+			continue
+		}
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(raw.Raw, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := obj.(*v1.List)
+		if !ok {
+			objects = append(objects, obj)
+			continue
+		}
+		for _, item := range list.Items {
+			itemObj, _, err := scheme.Codecs.UniversalDeserializer().Decode(item.Raw, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, itemObj)
+		}
+	}
+}
+
+// Write stores the objects into the directory, one file per kind, e.g. pod.yaml.
+// The directory is created when it does not exist.
+func Write(dir string, objects []runtime.Object) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("unable to create the snapshot directory: %v", err)
+	}
+	byKind := map[string][][]byte{}
+	for _, obj := range objects {
+		gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+		if err != nil {
+			return err
+		}
+		obj = obj.DeepCopyObject()
+		obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		byKind[gvks[0].Kind] = append(byKind[gvks[0].Kind], data)
+	}
+	kinds := make([]string, 0, len(byKind))
+	for kind := range byKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		path := filepath.Join(dir, strings.ToLower(kind)+".yaml")
+		if err := os.WriteFile(path, bytes.Join(byKind[kind], []byte("---\n")), 0o644); err != nil {
+			return fmt.Errorf("unable to write %s: %v", path, err)
+		}
+	}
+	return nil
+}
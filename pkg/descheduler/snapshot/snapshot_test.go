@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func objectNames(objects []runtime.Object) []string {
+	var names []string
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *v1.Pod:
+			names = append(names, "pod/"+o.Namespace+"/"+o.Name)
+		case *v1.Node:
+			names = append(names, "node/"+o.Name)
+		case *v1.Namespace:
+			names = append(names, "namespace/"+o.Name)
+		default:
+			names = append(names, "unexpected")
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestWriteAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	node := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	pod := test.BuildTestPod("p1", 100, 0, node.Name, nil)
+	if err := Write(dir, []runtime.Object{node, pod}); err != nil {
+		t.Fatalf("Unable to write the snapshot: %v", err)
+	}
+
+	objects, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Unable to load the snapshot: %v", err)
+	}
+	if diff := cmp.Diff([]string{"node/n1", "pod/default/p1"}, objectNames(objects)); diff != "" {
+		t.Errorf("Unexpected objects (-want +got):\n%s", diff)
+	}
+	for _, obj := range objects {
+		if loaded, ok := obj.(*v1.Pod); ok && loaded.UID != pod.UID {
+			t.Errorf("Expected the pod UID %v, got %v", pod.UID, loaded.UID)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	files := map[string]string{
+		// output of kubectl get -o yaml
+		"nodes.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Node
+  metadata:
+    name: n1
+- apiVersion: v1
+  kind: Node
+  metadata:
+    name: n2
+`,
+		// multiple documents
+		"pods.yml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: p1
+  namespace: default
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: p2
+  namespace: default
+`,
+		"namespace.json": `{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "default"}}`,
+		"README.md":      `not a snapshot file`,
+	}
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	objects, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Unable to load the snapshot: %v", err)
+	}
+	if diff := cmp.Diff([]string{"namespace/default", "node/n1", "node/n2", "pod/default/p1", "pod/default/p2"}, objectNames(objects)); diff != "" {
+		t.Errorf("Unexpected objects (-want +got):\n%s", diff)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "widgets.yaml"), []byte("apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(dir); err == nil {
+		t.Errorf("Expected an error loading an object of an unknown kind")
+	}
+}
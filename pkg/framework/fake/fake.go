@@ -4,12 +4,14 @@ import (
 	"context"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 
 	promapi "github.com/prometheus/client_golang/api"
@@ -19,10 +21,14 @@ type HandleImpl struct {
 	ClientsetImpl                 clientset.Interface
 	GetPodsAssignedToNodeFuncImpl podutil.GetPodsAssignedToNodeFunc
 	SharedInformerFactoryImpl     informers.SharedInformerFactory
+	DynamicInformerFactoryImpl    dynamicinformer.DynamicSharedInformerFactory
 	EvictorFilterImpl             frameworktypes.EvictorPlugin
+	PreSortPluginsImpl            []frameworktypes.PreSortPlugin
+	SortPluginsImpl               []frameworktypes.SortPlugin
 	PodEvictorImpl                *evictions.PodEvictor
 	MetricsCollectorImpl          *metricscollector.MetricsCollector
 	PrometheusClientImpl          promapi.Client
+	ParallelizerImpl              parallelize.Parallelizer
 }
 
 var _ frameworktypes.Handle = &HandleImpl{}
@@ -47,16 +53,55 @@ func (hi *HandleImpl) SharedInformerFactory() informers.SharedInformerFactory {
 	return hi.SharedInformerFactoryImpl
 }
 
+func (hi *HandleImpl) DynamicInformerFactory() dynamicinformer.DynamicSharedInformerFactory {
+	return hi.DynamicInformerFactoryImpl
+}
+
+func (hi *HandleImpl) Parallelizer() parallelize.Parallelizer {
+	return hi.ParallelizerImpl
+}
+
 func (hi *HandleImpl) Evictor() frameworktypes.Evictor {
 	return hi
 }
 
 func (hi *HandleImpl) Filter(pod *v1.Pod) bool {
-	return hi.EvictorFilterImpl.Filter(pod)
+	return hi.EvictorFilterImpl.Filter(pod).Allowed()
 }
 
 func (hi *HandleImpl) PreEvictionFilter(pod *v1.Pod) bool {
-	return hi.EvictorFilterImpl.PreEvictionFilter(pod)
+	return hi.EvictorFilterImpl.PreEvictionFilter(pod).Allowed()
+}
+
+func (hi *HandleImpl) FilterVerdicts(pod *v1.Pod) frameworktypes.FilterVerdicts {
+	return frameworktypes.FilterVerdicts{hi.verdict(hi.EvictorFilterImpl.Filter(pod))}
+}
+
+func (hi *HandleImpl) PreEvictionFilterVerdicts(pod *v1.Pod) frameworktypes.FilterVerdicts {
+	return frameworktypes.FilterVerdicts{hi.verdict(hi.EvictorFilterImpl.PreEvictionFilter(pod))}
+}
+
+func (hi *HandleImpl) verdict(verdict *frameworktypes.FilterVerdict) *frameworktypes.FilterVerdict {
+	if verdict == nil {
+		return frameworktypes.NewFilterVerdict(hi.EvictorFilterImpl.Name())
+	}
+	return verdict
+}
+
+func (hi *HandleImpl) PreSort(pods []*v1.Pod) {
+	lessFuncs := []podutil.LessFunc{}
+	for _, pl := range hi.PreSortPluginsImpl {
+		lessFuncs = append(lessFuncs, pl.PreLess)
+	}
+	podutil.SortPodsBasedOnLessFuncs(pods, lessFuncs...)
+}
+
+func (hi *HandleImpl) Sort(pods []*v1.Pod) {
+	lessFuncs := []podutil.LessFunc{}
+	for _, pl := range hi.SortPluginsImpl {
+		lessFuncs = append(lessFuncs, pl.Less)
+	}
+	podutil.SortPodsBasedOnLessFuncs(pods, lessFuncs...)
 }
 
 func (hi *HandleImpl) Evict(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
@@ -18,8 +18,12 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 )
@@ -96,12 +100,12 @@ func (d *FakePlugin) Name() string {
 	return d.PluginName
 }
 
-func (d *FakePlugin) PreEvictionFilter(pod *v1.Pod) bool {
-	return true
+func (d *FakePlugin) PreEvictionFilter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	return nil
 }
 
-func (d *FakePlugin) Filter(pod *v1.Pod) bool {
-	return true
+func (d *FakePlugin) Filter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	return nil
 }
 
 func (d *FakePlugin) handleAction(action Action) *frameworktypes.Status {
@@ -375,8 +379,8 @@ func (d *FakeFilterPlugin) Name() string {
 	return d.PluginName
 }
 
-func (d *FakeFilterPlugin) Filter(pod *v1.Pod) bool {
-	return d.handleBoolAction(&FilterActionImpl{
+func (d *FakeFilterPlugin) Filter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	return d.handleFilterAction(&FilterActionImpl{
 		ActionImpl: ActionImpl{
 			handle:         d.handle,
 			extensionPoint: string(frameworktypes.FilterExtensionPoint),
@@ -384,8 +388,8 @@ func (d *FakeFilterPlugin) Filter(pod *v1.Pod) bool {
 	})
 }
 
-func (d *FakeFilterPlugin) PreEvictionFilter(pod *v1.Pod) bool {
-	return d.handleBoolAction(&PreEvictionFilterActionImpl{
+func (d *FakeFilterPlugin) PreEvictionFilter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	return d.handleFilterAction(&PreEvictionFilterActionImpl{
 		ActionImpl: ActionImpl{
 			handle:         d.handle,
 			extensionPoint: string(frameworktypes.PreEvictionFilterExtensionPoint),
@@ -393,7 +397,7 @@ func (d *FakeFilterPlugin) PreEvictionFilter(pod *v1.Pod) bool {
 	})
 }
 
-func (d *FakeFilterPlugin) handleBoolAction(action Action) bool {
+func (d *FakeFilterPlugin) handleFilterAction(action Action) *frameworktypes.FilterVerdict {
 	actionCopy := action.DeepCopy()
 	for _, reactor := range d.ReactionChain {
 		if !reactor.Handles(actionCopy) {
@@ -404,7 +408,215 @@ func (d *FakeFilterPlugin) handleBoolAction(action Action) bool {
 			continue
 		}
 
-		return filter
+		if filter {
+			return nil
+		}
+		return frameworktypes.NewFilterVerdict(d.PluginName, frameworktypes.FilterReason{Code: "Rejected", Message: fmt.Sprintf("rejected by %q reactor", action.GetExtensionPoint())})
 	}
 	panic(fmt.Errorf("unhandled %q action", action.GetExtensionPoint()))
 }
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FakeLifecyclePluginArgs holds arguments used to configure FakeLifecyclePlugin plugin.
+type FakeLifecyclePluginArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// FakeLifecyclePlugin is a deschedule plugin recording the lifecycle
+// and extension point invocations, used for testing
+type FakeLifecyclePlugin struct {
+	PluginName string
+
+	// InitErr and BeforeCycleErr are returned by Init and BeforeCycle respectively
+	InitErr        error
+	BeforeCycleErr error
+
+	// Calls lists the invoked methods in the order of invocation
+	Calls []string
+
+	args   runtime.Object
+	handle frameworktypes.Handle
+}
+
+var _ frameworktypes.LifecyclePlugin = &FakeLifecyclePlugin{}
+
+func NewFakeLifecyclePluginFncFromFake(fp *FakeLifecyclePlugin) pluginregistry.PluginBuilder {
+	return func(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+		fakePluginArgs, ok := args.(*FakeLifecyclePluginArgs)
+		if !ok {
+			return nil, fmt.Errorf("want args to be of type FakeLifecyclePluginArgs, got %T", args)
+		}
+
+		fp.handle = handle
+		fp.args = fakePluginArgs
+		fp.Calls = append(fp.Calls, "New")
+
+		return fp, nil
+	}
+}
+
+// Name retrieves the plugin name
+func (d *FakeLifecyclePlugin) Name() string {
+	return d.PluginName
+}
+
+func (d *FakeLifecyclePlugin) Init(ctx context.Context) error {
+	d.Calls = append(d.Calls, "Init")
+	return d.InitErr
+}
+
+func (d *FakeLifecyclePlugin) BeforeCycle(ctx context.Context) error {
+	d.Calls = append(d.Calls, "BeforeCycle")
+	return d.BeforeCycleErr
+}
+
+func (d *FakeLifecyclePlugin) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	d.Calls = append(d.Calls, string(frameworktypes.DescheduleExtensionPoint))
+	return &frameworktypes.Status{}
+}
+
+func (d *FakeLifecyclePlugin) AfterCycle(ctx context.Context) {
+	d.Calls = append(d.Calls, "AfterCycle")
+}
+
+func (d *FakeLifecyclePlugin) Close() error {
+	d.Calls = append(d.Calls, "Close")
+	return nil
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FakePostEvictionPluginArgs holds arguments used to configure FakePostEvictionPlugin plugin.
+type FakePostEvictionPluginArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// FakePostEvictionPlugin is a postEviction plugin recording the eviction attempts, used for testing
+type FakePostEvictionPlugin struct {
+	PluginName string
+
+	// Err is returned by every PostEviction invocation
+	Err error
+
+	// Evictions lists the eviction attempts in the order of invocation
+	Evictions []FakeEviction
+
+	args   runtime.Object
+	handle frameworktypes.Handle
+}
+
+// FakeEviction is an eviction attempt observed by FakePostEvictionPlugin
+type FakeEviction struct {
+	Pod          string
+	StrategyName string
+	ProfileName  string
+	Reason       string
+	Err          error
+}
+
+var _ frameworktypes.PostEvictionPlugin = &FakePostEvictionPlugin{}
+
+func NewFakePostEvictionPluginFncFromFake(fp *FakePostEvictionPlugin) pluginregistry.PluginBuilder {
+	return func(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+		fakePluginArgs, ok := args.(*FakePostEvictionPluginArgs)
+		if !ok {
+			return nil, fmt.Errorf("want args to be of type FakePostEvictionPluginArgs, got %T", args)
+		}
+
+		fp.handle = handle
+		fp.args = fakePluginArgs
+
+		return fp, nil
+	}
+}
+
+// Name retrieves the plugin name
+func (d *FakePostEvictionPlugin) Name() string {
+	return d.PluginName
+}
+
+func (d *FakePostEvictionPlugin) PostEviction(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions, evictionErr error) *frameworktypes.Status {
+	d.Evictions = append(d.Evictions, FakeEviction{
+		Pod:          pod.Namespace + "/" + pod.Name,
+		StrategyName: opts.StrategyName,
+		ProfileName:  opts.ProfileName,
+		Reason:       opts.Reason,
+		Err:          evictionErr,
+	})
+	return &frameworktypes.Status{Err: d.Err}
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FakeResourcesPluginArgs holds arguments used to configure FakeResourcesPlugin plugin.
+type FakeResourcesPluginArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// FakeResourcesPlugin is a deschedule plugin declaring the resources it needs and
+// listing objects of the dynamic resources on every invocation, used for testing
+type FakeResourcesPlugin struct {
+	PluginName string
+
+	// Resources are returned by RequiredResources
+	Resources []schema.GroupVersionResource
+	// DynamicResources are listed through the dynamic informer factory on every Deschedule invocation
+	DynamicResources []schema.GroupVersionResource
+
+	// Listed contains names of the listed objects of every Deschedule invocation
+	Listed [][]string
+
+	args   runtime.Object
+	handle frameworktypes.Handle
+}
+
+var _ frameworktypes.ResourcesPlugin = &FakeResourcesPlugin{}
+
+func NewFakeResourcesPluginFncFromFake(fp *FakeResourcesPlugin) pluginregistry.PluginBuilder {
+	return func(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+		fakePluginArgs, ok := args.(*FakeResourcesPluginArgs)
+		if !ok {
+			return nil, fmt.Errorf("want args to be of type FakeResourcesPluginArgs, got %T", args)
+		}
+
+		fp.handle = handle
+		fp.args = fakePluginArgs
+
+		return fp, nil
+	}
+}
+
+// Name retrieves the plugin name
+func (d *FakeResourcesPlugin) Name() string {
+	return d.PluginName
+}
+
+func (d *FakeResourcesPlugin) RequiredResources() []schema.GroupVersionResource {
+	return d.Resources
+}
+
+func (d *FakeResourcesPlugin) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	listed := []string{}
+	for _, resource := range d.DynamicResources {
+		if d.handle.DynamicInformerFactory() == nil {
+			return &frameworktypes.Status{Err: fmt.Errorf("dynamic informer factory missing")}
+		}
+		objects, err := d.handle.DynamicInformerFactory().ForResource(resource).Lister().List(labels.Everything())
+		if err != nil {
+			return &frameworktypes.Status{Err: err}
+		}
+		for _, object := range objects {
+			accessor, err := meta.Accessor(object)
+			if err != nil {
+				return &frameworktypes.Status{Err: err}
+			}
+			listed = append(listed, accessor.GetNamespace()+"/"+accessor.GetName())
+		}
+	}
+	d.Listed = append(d.Listed, listed)
+	return &frameworktypes.Status{}
+}
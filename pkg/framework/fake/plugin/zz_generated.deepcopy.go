@@ -100,6 +100,31 @@ func (in *FakeFilterPluginArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FakeLifecyclePluginArgs) DeepCopyInto(out *FakeLifecyclePluginArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeLifecyclePluginArgs.
+func (in *FakeLifecyclePluginArgs) DeepCopy() *FakeLifecyclePluginArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(FakeLifecyclePluginArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FakeLifecyclePluginArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FakePluginArgs) DeepCopyInto(out *FakePluginArgs) {
 	*out = *in
@@ -124,3 +149,53 @@ func (in *FakePluginArgs) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FakePostEvictionPluginArgs) DeepCopyInto(out *FakePostEvictionPluginArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakePostEvictionPluginArgs.
+func (in *FakePostEvictionPluginArgs) DeepCopy() *FakePostEvictionPluginArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(FakePostEvictionPluginArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FakePostEvictionPluginArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FakeResourcesPluginArgs) DeepCopyInto(out *FakeResourcesPluginArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeResourcesPluginArgs.
+func (in *FakeResourcesPluginArgs) DeepCopy() *FakeResourcesPluginArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(FakeResourcesPluginArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FakeResourcesPluginArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
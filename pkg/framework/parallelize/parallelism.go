@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelize
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// DefaultParallelism runs all the work pieces sequentially
+const DefaultParallelism int = 1
+
+// ErrStop is returned by a work piece to stop processing of the remaining
+// pieces without reporting an error, e.g. once the total eviction limit is reached.
+var ErrStop = errors.New("stop processing the remaining pieces")
+
+// Parallelizer runs work pieces in a bounded pool of workers
+type Parallelizer struct {
+	parallelism int
+}
+
+// NewParallelizer returns a parallelizer running at most parallelism
+// work pieces at the same time. Values lower than 1 are treated as 1.
+func NewParallelizer(parallelism int) Parallelizer {
+	if parallelism < DefaultParallelism {
+		parallelism = DefaultParallelism
+	}
+	return Parallelizer{parallelism: parallelism}
+}
+
+// Parallelism returns the number of workers
+func (p Parallelizer) Parallelism() int {
+	if p.parallelism < DefaultParallelism {
+		return DefaultParallelism
+	}
+	return p.parallelism
+}
+
+// Until runs doWorkPiece for every piece in [0, pieces). No new pieces are
+// started once the context is done. With the parallelism of 1 the pieces are
+// processed in order, in the calling goroutine.
+func (p Parallelizer) Until(ctx context.Context, pieces int, doWorkPiece workqueue.DoWorkPieceFunc) {
+	if p.Parallelism() == DefaultParallelism {
+		for piece := 0; piece < pieces; piece++ {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			doWorkPiece(piece)
+		}
+		return
+	}
+	workqueue.ParallelizeUntil(ctx, p.Parallelism(), pieces, doWorkPiece)
+}
+
+// UntilError runs doWorkPiece for every piece in [0, pieces) like Until.
+// No new pieces are started once any of the pieces returns an error.
+// The first error other than ErrStop is returned.
+func (p Parallelizer) UntilError(ctx context.Context, pieces int, doWorkPiece func(piece int) error) error {
+	// the context only stops new pieces from starting, pieces already
+	// running are expected to finish with the context of the caller
+	stopCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	var mu sync.Mutex
+	var firstErr error
+	p.Until(stopCtx, pieces, func(piece int) {
+		err := doWorkPiece(piece)
+		if err == nil {
+			return
+		}
+		stop()
+		if errors.Is(err, ErrStop) {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+	})
+	return firstErr
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelize
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUntilSequential(t *testing.T) {
+	var processed []int
+	NewParallelizer(DefaultParallelism).Until(context.Background(), 5, func(piece int) {
+		processed = append(processed, piece)
+	})
+	if diff := cmp.Diff([]int{0, 1, 2, 3, 4}, processed); diff != "" {
+		t.Errorf("unexpected pieces processed (-want +got):\n%s", diff)
+	}
+}
+
+func TestUntilError(t *testing.T) {
+	tests := []struct {
+		description  string
+		parallelism  int
+		pieceErr     func(piece int) error
+		expectedErr  error
+		maxProcessed int32
+	}{
+		{
+			description: "all pieces processed",
+			parallelism: 4,
+			pieceErr: func(piece int) error {
+				return nil
+			},
+			maxProcessed: 100,
+		},
+		{
+			description: "stop sequentially",
+			parallelism: 1,
+			pieceErr: func(piece int) error {
+				if piece == 2 {
+					return ErrStop
+				}
+				return nil
+			},
+			maxProcessed: 3,
+		},
+		{
+			description: "error sequentially",
+			parallelism: 1,
+			pieceErr: func(piece int) error {
+				if piece == 2 {
+					return fmt.Errorf("piece %v failed", piece)
+				}
+				return nil
+			},
+			expectedErr:  fmt.Errorf("piece 2 failed"),
+			maxProcessed: 3,
+		},
+		{
+			description: "error in parallel",
+			parallelism: 4,
+			pieceErr: func(piece int) error {
+				return fmt.Errorf("failed")
+			},
+			expectedErr:  fmt.Errorf("failed"),
+			maxProcessed: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var processed int32
+			err := NewParallelizer(tc.parallelism).UntilError(context.Background(), 100, func(piece int) error {
+				atomic.AddInt32(&processed, 1)
+				return tc.pieceErr(piece)
+			})
+			if fmt.Sprint(err) != fmt.Sprint(tc.expectedErr) {
+				t.Errorf("expected error %v, got %v instead", tc.expectedErr, err)
+			}
+			if processed > tc.maxProcessed {
+				t.Errorf("expected at most %v pieces processed, got %v instead", tc.maxProcessed, processed)
+			}
+			if tc.expectedErr == nil && tc.maxProcessed == 100 && processed != 100 {
+				t.Errorf("expected all the pieces processed, got %v instead", processed)
+			}
+		})
+	}
+}
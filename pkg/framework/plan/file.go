@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+const (
+	// FileAPIVersion is the version of the plan file format
+	FileAPIVersion = "descheduler/v1alpha1"
+	// FileKind is the kind of the plan file
+	FileKind = "EvictionPlan"
+)
+
+// File is a reviewable list of evictions computed by a dry run of a descheduling cycle
+type File struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// CreationTimestamp is the time the plan was computed at
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Entries are the planned evictions grouped by the profile and the plugin which made them
+	Entries []Entry `json:"entries"`
+}
+
+// Entry is a single planned eviction
+type Entry struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	// Node the pod runs on
+	Node    string `json:"node"`
+	Profile string `json:"profile"`
+	Plugin  string `json:"plugin"`
+	Reason  string `json:"reason,omitempty"`
+	// TargetNode is a node the pod is expected to fit on once evicted, empty when no node fits
+	TargetNode string `json:"targetNode,omitempty"`
+}
+
+// EntryResult is the outcome of applying a planned eviction
+type EntryResult struct {
+	Entry Entry
+	// Err is nil when the pod got evicted, otherwise it tells why the entry was skipped or the eviction failed
+	Err error
+}
+
+// NewFile returns a plan file with the given entries
+func NewFile(entries []Entry) *File {
+	return &File{
+		APIVersion:        FileAPIVersion,
+		Kind:              FileKind,
+		CreationTimestamp: metav1.NewTime(time.Now()),
+		Entries:           entries,
+	}
+}
+
+// EntriesFromReports returns an entry for each pod evicted in the reports of a descheduling cycle.
+// The target nodes are left empty.
+func EntriesFromReports(reports []*frameworktypes.CycleReport) []Entry {
+	entries := []Entry{}
+	for _, report := range reports {
+		for _, pluginReport := range report.Plugins {
+			for _, evicted := range pluginReport.Status.Evicted {
+				entries = append(entries, Entry{
+					Namespace: evicted.Namespace,
+					Name:      evicted.Name,
+					UID:       evicted.UID,
+					Node:      evicted.Node,
+					Profile:   report.Profile,
+					Plugin:    pluginReport.Plugin,
+					Reason:    evicted.Reason,
+				})
+			}
+		}
+	}
+	return entries
+}
+
+// Encode writes the plan file as indented JSON
+func (f *File) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(f)
+}
+
+// DecodeFile reads a plan file and checks its version
+func DecodeFile(r io.Reader) (*File, error) {
+	f := &File{}
+	if err := json.NewDecoder(r).Decode(f); err != nil {
+		return nil, fmt.Errorf("unable to decode the plan: %v", err)
+	}
+	if f.APIVersion != FileAPIVersion || f.Kind != FileKind {
+		return nil, fmt.Errorf("unsupported plan %s/%s, expected %s/%s", f.APIVersion, f.Kind, FileAPIVersion, FileKind)
+	}
+	return f, nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+)
+
+// ExecuteFunc evicts a planned pod
+type ExecuteFunc func(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error
+
+// Candidate is a pod proposed for eviction by a plugin
+type Candidate struct {
+	Pod *v1.Pod
+	// Options carry the profile, the plugin, the reason and the score of the proposal
+	Options evictions.EvictOptions
+	// Proposers lists the profile/plugin pairs which proposed the pod, the first one wins
+	Proposers []string
+	// execute evicts the pod through the evictor of the winning profile
+	execute ExecuteFunc
+	// order of the proposal, earlier proposals win ties
+	order int
+}
+
+// Result is the outcome of the execution of a planned eviction
+type Result struct {
+	Candidate *Candidate
+	// Err is nil when the pod got evicted
+	Err error
+}
+
+// Planner collects the eviction candidates proposed by the plugins of all the profiles,
+// ranks them and executes the evictions. Plugins may propose from multiple goroutines.
+type Planner struct {
+	mu               sync.Mutex
+	profileWeights   map[string]uint
+	namespaceWeights map[string]uint
+	candidates       map[types.UID]*Candidate
+	proposals        int
+}
+
+// NewPlanner returns a planner sharing the evictions by the given weights.
+// Profiles and namespaces not listed have weight 1.
+func NewPlanner(profileWeights, namespaceWeights map[string]uint) *Planner {
+	return &Planner{
+		profileWeights:   profileWeights,
+		namespaceWeights: namespaceWeights,
+		candidates:       make(map[types.UID]*Candidate),
+	}
+}
+
+// Propose adds a candidate. A pod proposed multiple times is planned for
+// eviction once, by the proposal with the highest score.
+func (p *Planner) Propose(pod *v1.Pod, opts evictions.EvictOptions, execute ExecuteFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.proposals++
+	proposer := opts.ProfileName + "/" + opts.StrategyName
+	if c, ok := p.candidates[pod.UID]; ok {
+		if opts.Score > c.Options.Score {
+			c.Pod, c.Options, c.execute = pod, opts, execute
+			c.Proposers = append([]string{proposer}, c.Proposers...)
+		} else {
+			c.Proposers = append(c.Proposers, proposer)
+		}
+		return
+	}
+	p.candidates[pod.UID] = &Candidate{
+		Pod:       pod,
+		Options:   opts,
+		Proposers: []string{proposer},
+		execute:   execute,
+		order:     p.proposals,
+	}
+}
+
+// Len returns the number of distinct candidates
+func (p *Planner) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.candidates)
+}
+
+// Plan returns the candidates in the order they are evicted in. The evictions
+// are shared among the profiles by their weights first, the evictions of a
+// profile are shared among the namespaces by their weights. Candidates of the
+// same profile and namespace are ranked by their score, so when the eviction
+// limits are reached each profile and namespace got its share of the
+// evictions and the most beneficial ones were executed.
+func (p *Planner) Plan() []*Candidate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// queues of candidates per profile and namespace, ranked by score
+	queues := map[string]map[string][]*Candidate{}
+	for _, c := range p.candidates {
+		profile := c.Options.ProfileName
+		if queues[profile] == nil {
+			queues[profile] = map[string][]*Candidate{}
+		}
+		queues[profile][c.Pod.Namespace] = append(queues[profile][c.Pod.Namespace], c)
+	}
+	for _, namespaces := range queues {
+		for _, queue := range namespaces {
+			sort.SliceStable(queue, func(i, j int) bool {
+				return ranksBefore(queue[i], queue[j])
+			})
+		}
+	}
+
+	planned := make([]*Candidate, 0, len(p.candidates))
+	profileShares := map[string]uint64{}
+	// the namespaces share the evictions of each profile separately
+	namespaceShares := map[string]map[string]uint64{}
+	for len(planned) < len(p.candidates) {
+		profile := pickShare(queues, profileShares, p.profileWeights, func(namespaces map[string][]*Candidate) *Candidate {
+			return head(namespaces)
+		})
+		namespaces := queues[profile]
+		if namespaceShares[profile] == nil {
+			namespaceShares[profile] = map[string]uint64{}
+		}
+		namespace := pickShare(namespaces, namespaceShares[profile], p.namespaceWeights, func(queue []*Candidate) *Candidate {
+			return queue[0]
+		})
+
+		planned = append(planned, namespaces[namespace][0])
+		profileShares[profile]++
+		namespaceShares[profile][namespace]++
+		namespaces[namespace] = namespaces[namespace][1:]
+		if len(namespaces[namespace]) == 0 {
+			delete(namespaces, namespace)
+		}
+		if len(namespaces) == 0 {
+			delete(queues, profile)
+		}
+	}
+	return planned
+}
+
+// pickShare returns the key of the group furthest below its weighted share, i.e.
+// with the lowest (planned+1)/weight. Ties are broken by the best head candidate.
+func pickShare[T any](groups map[string]T, shares map[string]uint64, weights map[string]uint, headOf func(T) *Candidate) string {
+	var picked string
+	var pickedHead *Candidate
+	for key, group := range groups {
+		h := headOf(group)
+		if pickedHead == nil {
+			picked, pickedHead = key, h
+			continue
+		}
+		// (shares[key]+1)/weight(key) compared to (shares[picked]+1)/weight(picked)
+		lhs := (shares[key] + 1) * weight(weights, picked)
+		rhs := (shares[picked] + 1) * weight(weights, key)
+		if lhs < rhs || (lhs == rhs && ranksBefore(h, pickedHead)) {
+			picked, pickedHead = key, h
+		}
+	}
+	return picked
+}
+
+func weight(weights map[string]uint, key string) uint64 {
+	if w, ok := weights[key]; ok && w > 0 {
+		return uint64(w)
+	}
+	return 1
+}
+
+// head returns the best ranked candidate among the heads of the queues
+func head(queues map[string][]*Candidate) *Candidate {
+	var best *Candidate
+	for _, queue := range queues {
+		if best == nil || ranksBefore(queue[0], best) {
+			best = queue[0]
+		}
+	}
+	return best
+}
+
+// ranksBefore orders candidates by score, earlier proposals first
+func ranksBefore(c1, c2 *Candidate) bool {
+	if c1.Options.Score != c2.Options.Score {
+		return c1.Options.Score > c2.Options.Score
+	}
+	return c1.order < c2.order
+}
+
+// Execute evicts the planned pods in order. Once the total eviction limit or a
+// rate limit not limited to a namespace is reached for a profile its remaining
+// candidates are not attempted.
+func (p *Planner) Execute(ctx context.Context) []Result {
+	planned := p.Plan()
+
+	var results []Result
+	stopped := map[string]bool{}
+	for _, c := range planned {
+		if ctx.Err() != nil {
+			break
+		}
+		if stopped[c.Options.ProfileName] {
+			continue
+		}
+		err := c.execute(ctx, c.Pod, c.Options)
+		if evictions.IsEvictionStopError(err) {
+			stopped[c.Options.ProfileName] = true
+		}
+		results = append(results, Result{Candidate: c, Err: err})
+	}
+	klog.V(1).InfoS("Eviction plan executed", "proposals", p.proposals, "candidates", len(planned), "attempted", len(results))
+	return results
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/test"
+)
+
+type proposal struct {
+	pod     *v1.Pod
+	profile string
+	plugin  string
+	score   float64
+}
+
+func buildPod(name, namespace string) *v1.Pod {
+	return test.BuildTestPod(name, 100, 0, "n1", func(pod *v1.Pod) {
+		pod.Namespace = namespace
+	})
+}
+
+func names(candidates []*Candidate) []string {
+	var names []string
+	for _, c := range candidates {
+		names = append(names, c.Pod.Name)
+	}
+	return names
+}
+
+func TestPlan(t *testing.T) {
+	a1, a2, a3 := buildPod("a1", "a"), buildPod("a2", "a"), buildPod("a3", "a")
+	b1, b2 := buildPod("b1", "b"), buildPod("b2", "b")
+
+	tests := []struct {
+		description      string
+		profileWeights   map[string]uint
+		namespaceWeights map[string]uint
+		proposals        []proposal
+		expected         []string
+	}{
+		{
+			description: "candidates ranked by score, ties in the proposal order",
+			proposals: []proposal{
+				{pod: a1, profile: "p1", score: 1},
+				{pod: a2, profile: "p1", score: 5},
+				{pod: a3, profile: "p1", score: 1},
+			},
+			expected: []string{"a2", "a1", "a3"},
+		},
+		{
+			description: "namespaces share the evictions evenly",
+			proposals: []proposal{
+				{pod: a1, profile: "p1", score: 3},
+				{pod: a2, profile: "p1", score: 3},
+				{pod: a3, profile: "p1", score: 3},
+				{pod: b1, profile: "p1", score: 1},
+				{pod: b2, profile: "p1", score: 1},
+			},
+			expected: []string{"a1", "b1", "a2", "b2", "a3"},
+		},
+		{
+			description:      "namespaces share the evictions by their weights",
+			namespaceWeights: map[string]uint{"a": 2},
+			proposals: []proposal{
+				{pod: b1, profile: "p1", score: 5},
+				{pod: b2, profile: "p1", score: 5},
+				{pod: a1, profile: "p1"},
+				{pod: a2, profile: "p1"},
+				{pod: a3, profile: "p1"},
+			},
+			expected: []string{"a1", "b1", "a2", "a3", "b2"},
+		},
+		{
+			description:    "profiles share the evictions by their weights",
+			profileWeights: map[string]uint{"p2": 2},
+			proposals: []proposal{
+				{pod: a1, profile: "p1", score: 9},
+				{pod: a2, profile: "p1", score: 9},
+				{pod: b1, profile: "p2"},
+				{pod: b2, profile: "p2"},
+				{pod: a3, profile: "p2"},
+			},
+			expected: []string{"b1", "a1", "a3", "b2", "a2"},
+		},
+		{
+			description: "namespaces share the evictions of each profile separately",
+			proposals: []proposal{
+				{pod: a1, profile: "p1", score: 9},
+				{pod: a2, profile: "p1", score: 9},
+				{pod: b1, profile: "p1", score: 1},
+				{pod: b2, profile: "p1", score: 1},
+				{pod: a3, profile: "p2", score: 5},
+			},
+			// the eviction of a3 by p2 does not count in the share of the namespace within p1
+			expected: []string{"a1", "a3", "b1", "a2", "b2"},
+		},
+		{
+			description: "pod proposed twice planned once by the best proposal",
+			proposals: []proposal{
+				{pod: a1, profile: "p1", plugin: "x", score: 1},
+				{pod: a2, profile: "p1", plugin: "x", score: 2},
+				{pod: a1, profile: "p1", plugin: "y", score: 3},
+			},
+			expected: []string{"a1", "a2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			planner := NewPlanner(tc.profileWeights, tc.namespaceWeights)
+			for _, p := range tc.proposals {
+				planner.Propose(p.pod, evictions.EvictOptions{ProfileName: p.profile, StrategyName: p.plugin, Score: p.score}, nil)
+			}
+			if diff := cmp.Diff(tc.expected, names(planner.Plan())); diff != "" {
+				t.Errorf("unexpected plan (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanProposers(t *testing.T) {
+	pod := buildPod("a1", "a")
+	planner := NewPlanner(nil, nil)
+	planner.Propose(pod, evictions.EvictOptions{ProfileName: "p1", StrategyName: "x", Score: 1}, nil)
+	planner.Propose(pod, evictions.EvictOptions{ProfileName: "p2", StrategyName: "y", Score: 2}, nil)
+	planner.Propose(pod, evictions.EvictOptions{ProfileName: "p1", StrategyName: "z", Score: 2}, nil)
+
+	if planner.Len() != 1 {
+		t.Fatalf("expected a single candidate, got %v", planner.Len())
+	}
+	candidate := planner.Plan()[0]
+	if candidate.Options.ProfileName != "p2" {
+		t.Errorf("expected the candidate planned by profile p2, got %v", candidate.Options.ProfileName)
+	}
+	if diff := cmp.Diff([]string{"p2/y", "p1/x", "p1/z"}, candidate.Proposers); diff != "" {
+		t.Errorf("unexpected proposers (-want +got):\n%s", diff)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	var executed []string
+	// p1 hits its total limit after the first eviction, p2 evicts all its candidates
+	limits := map[string]int{"p1": 1, "p2": 3}
+	execute := func(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
+		executed = append(executed, pod.Name)
+		if limits[opts.ProfileName] == 0 {
+			return evictions.NewEvictionTotalLimitError()
+		}
+		limits[opts.ProfileName]--
+		return nil
+	}
+
+	planner := NewPlanner(nil, nil)
+	for _, p := range []proposal{
+		{pod: buildPod("a1", "a"), profile: "p1", score: 3},
+		{pod: buildPod("a2", "a"), profile: "p1", score: 2},
+		{pod: buildPod("a3", "a"), profile: "p1", score: 1},
+		{pod: buildPod("b1", "b"), profile: "p2"},
+		{pod: buildPod("b2", "b"), profile: "p2"},
+	} {
+		planner.Propose(p.pod, evictions.EvictOptions{ProfileName: p.profile, Score: p.score}, execute)
+	}
+
+	results := planner.Execute(context.Background())
+	if diff := cmp.Diff([]string{"a1", "b1", "a2", "b2"}, executed); diff != "" {
+		t.Errorf("unexpected evictions (-want +got):\n%s", diff)
+	}
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if len(results) != 4 || failed != 1 {
+		t.Errorf("expected 4 results with 1 failure, got %v results with %v failures", len(results), failed)
+	}
+}
+
+func TestExecuteRateLimits(t *testing.T) {
+	var executed []string
+	// namespace a hits its rate limit, the other namespaces keep evicting until the
+	// rate limit of all the namespaces is reached
+	execute := func(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
+		executed = append(executed, pod.Name)
+		switch {
+		case pod.Namespace == "a":
+			return evictions.NewEvictionRateLimitError(pod.Namespace, time.Hour)
+		case len(executed) > 3:
+			return evictions.NewEvictionRateLimitError("", time.Hour)
+		}
+		return nil
+	}
+
+	planner := NewPlanner(nil, nil)
+	for _, p := range []proposal{
+		{pod: buildPod("a1", "a"), profile: "p1", score: 3},
+		{pod: buildPod("a2", "a"), profile: "p1", score: 2},
+		{pod: buildPod("b1", "b"), profile: "p1"},
+		{pod: buildPod("b2", "b"), profile: "p1"},
+		{pod: buildPod("b3", "b"), profile: "p1"},
+	} {
+		planner.Propose(p.pod, evictions.EvictOptions{ProfileName: p.profile, Score: p.score}, execute)
+	}
+
+	planner.Execute(context.Background())
+	if diff := cmp.Diff([]string{"a1", "b1", "a2", "b2"}, executed); diff != "" {
+		t.Errorf("unexpected evictions (-want +got):\n%s", diff)
+	}
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	file := NewFile([]Entry{{Namespace: "a", Name: "a1", UID: "uid", Node: "n1", Profile: "p1", Plugin: "x", TargetNode: "n2"}})
+	buf := &bytes.Buffer{}
+	if err := file.Encode(buf); err != nil {
+		t.Fatalf("Unable to encode the plan: %v", err)
+	}
+	decoded, err := DecodeFile(buf)
+	if err != nil {
+		t.Fatalf("Unable to decode the plan: %v", err)
+	}
+	if diff := cmp.Diff(file.Entries, decoded.Entries); diff != "" {
+		t.Errorf("unexpected entries (-want +got):\n%s", diff)
+	}
+
+	if _, err := DecodeFile(strings.NewReader(`{"apiVersion":"descheduler/v1","kind":"EvictionPlan"}`)); err == nil {
+		t.Errorf("expected an error decoding a plan of an unsupported version")
+	}
+}
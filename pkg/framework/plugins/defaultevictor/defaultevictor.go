@@ -16,15 +16,16 @@ package defaultevictor
 import (
 	// "context"
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
@@ -38,9 +39,34 @@ const (
 	evictPodAnnotationKey = "descheduler.alpha.kubernetes.io/evict"
 )
 
-var _ frameworktypes.EvictorPlugin = &DefaultEvictor{}
+// Reason codes of the DefaultEvictor filter verdicts
+const (
+	ReasonMirrorPod              frameworktypes.FilterReasonCode = "MirrorPod"
+	ReasonStaticPod              frameworktypes.FilterReasonCode = "StaticPod"
+	ReasonTerminating            frameworktypes.FilterReasonCode = "Terminating"
+	ReasonNoOwnerReferences      frameworktypes.FilterReasonCode = "NoOwnerReferences"
+	ReasonSystemCriticalPriority frameworktypes.FilterReasonCode = "SystemCriticalPriority"
+	ReasonPriorityThreshold      frameworktypes.FilterReasonCode = "PriorityThreshold"
+	ReasonLocalStorage           frameworktypes.FilterReasonCode = "LocalStorage"
+	ReasonDaemonSetPod           frameworktypes.FilterReasonCode = "DaemonSetPod"
+	ReasonPVC                    frameworktypes.FilterReasonCode = "PVC"
+	ReasonLabelSelector          frameworktypes.FilterReasonCode = "LabelSelector"
+	ReasonMinReplicas            frameworktypes.FilterReasonCode = "MinReplicas"
+	ReasonMinPodAge              frameworktypes.FilterReasonCode = "MinPodAge"
+	ReasonNoPodDisruptionBudget  frameworktypes.FilterReasonCode = "NoPodDisruptionBudget"
+	ReasonNodeFit                frameworktypes.FilterReasonCode = "NodeFit"
+)
 
-type constraint func(pod *v1.Pod) error
+var (
+	_ frameworktypes.EvictorPlugin   = &DefaultEvictor{}
+	_ frameworktypes.ResourcesPlugin = &DefaultEvictor{}
+)
+
+type constraint func(pod *v1.Pod) *frameworktypes.FilterReason
+
+func newReason(code frameworktypes.FilterReasonCode, format string, args ...interface{}) *frameworktypes.FilterReason {
+	return &frameworktypes.FilterReason{Code: code, Message: fmt.Sprintf(format, args...)}
+}
 
 // DefaultEvictor is the first EvictorPlugin, which defines the default extension points of the
 // pre-baked evictor that is shipped.
@@ -79,27 +105,27 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 
 	if defaultEvictorArgs.EvictFailedBarePods {
 		klog.V(1).InfoS("Warning: EvictFailedBarePods is set to True. This could cause eviction of pods without ownerReferences.")
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			ownerRefList := podutil.OwnerRef(pod)
 			// Enable evictFailedBarePods to evict bare pods in failed phase
 			if len(ownerRefList) == 0 && pod.Status.Phase != v1.PodFailed {
-				return fmt.Errorf("pod does not have any ownerRefs and is not in failed phase")
+				return newReason(ReasonNoOwnerReferences, "pod does not have any ownerRefs and is not in failed phase")
 			}
 			return nil
 		})
 	} else {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			ownerRefList := podutil.OwnerRef(pod)
 			if len(ownerRefList) == 0 {
-				return fmt.Errorf("pod does not have any ownerRefs")
+				return newReason(ReasonNoOwnerReferences, "pod does not have any ownerRefs")
 			}
 			return nil
 		})
 	}
 	if !defaultEvictorArgs.EvictSystemCriticalPods {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			if utils.IsCriticalPriorityPod(pod) {
-				return fmt.Errorf("pod has system critical priority")
+				return newReason(ReasonSystemCriticalPriority, "pod has system critical priority")
 			}
 			return nil
 		})
@@ -109,37 +135,37 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 			if err != nil {
 				return nil, fmt.Errorf("failed to get priority threshold: %v", err)
 			}
-			ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+			ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 				if IsPodEvictableBasedOnPriority(pod, thresholdPriority) {
 					return nil
 				}
-				return fmt.Errorf("pod has higher priority than specified priority class threshold")
+				return newReason(ReasonPriorityThreshold, "pod has higher priority than specified priority class threshold")
 			})
 		}
 	} else {
 		klog.V(1).InfoS("Warning: EvictSystemCriticalPods is set to True. This could cause eviction of Kubernetes system pods.")
 	}
 	if !defaultEvictorArgs.EvictLocalStoragePods {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			if utils.IsPodWithLocalStorage(pod) {
-				return fmt.Errorf("pod has local storage and descheduler is not configured with evictLocalStoragePods")
+				return newReason(ReasonLocalStorage, "pod has local storage and descheduler is not configured with evictLocalStoragePods")
 			}
 			return nil
 		})
 	}
 	if !defaultEvictorArgs.EvictDaemonSetPods {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			ownerRefList := podutil.OwnerRef(pod)
 			if utils.IsDaemonsetPod(ownerRefList) {
-				return fmt.Errorf("pod is related to daemonset and descheduler is not configured with evictDaemonSetPods")
+				return newReason(ReasonDaemonSetPod, "pod is related to daemonset and descheduler is not configured with evictDaemonSetPods")
 			}
 			return nil
 		})
 	}
 	if defaultEvictorArgs.IgnorePvcPods {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			if utils.IsPodWithPVC(pod) {
-				return fmt.Errorf("pod has a PVC and descheduler is configured to ignore PVC pods")
+				return newReason(ReasonPVC, "pod has a PVC and descheduler is configured to ignore PVC pods")
 			}
 			return nil
 		})
@@ -149,21 +175,20 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 		return nil, fmt.Errorf("could not get selector from label selector")
 	}
 	if defaultEvictorArgs.LabelSelector != nil && !selector.Empty() {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			if !selector.Matches(labels.Set(pod.Labels)) {
-				return fmt.Errorf("pod labels do not match the labelSelector filter in the policy parameter")
+				return newReason(ReasonLabelSelector, "pod labels do not match the labelSelector filter in the policy parameter")
 			}
 			return nil
 		})
 	}
 
 	if defaultEvictorArgs.MinReplicas > 1 {
-		indexName := "metadata.ownerReferences"
-		indexer, err := getPodIndexerByOwnerRefs(indexName, handle)
-		if err != nil {
+		indexName := podutil.OwnerRefUIDsIndex
+		if _, err := getPodIndexerByOwnerRefs(indexName, handle); err != nil {
 			return nil, err
 		}
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			if len(pod.OwnerReferences) == 0 {
 				return nil
 			}
@@ -173,14 +198,20 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 				return nil
 			}
 
+			// the indexer is looked up every time as the informers
+			// behind the handle may change between descheduling cycles
+			indexer, err := getPodIndexerByOwnerRefs(indexName, handle)
+			if err != nil {
+				return newReason(ReasonMinReplicas, "unable to get the pod indexer for minReplicas filter: %v", err)
+			}
 			ownerRef := pod.OwnerReferences[0]
 			objs, err := indexer.ByIndex(indexName, string(ownerRef.UID))
 			if err != nil {
-				return fmt.Errorf("unable to list pods for minReplicas filter in the policy parameter")
+				return newReason(ReasonMinReplicas, "unable to list pods for minReplicas filter in the policy parameter")
 			}
 
 			if uint(len(objs)) < defaultEvictorArgs.MinReplicas {
-				return fmt.Errorf("owner has %d replicas which is less than minReplicas of %d", len(objs), defaultEvictorArgs.MinReplicas)
+				return newReason(ReasonMinReplicas, "owner has %d replicas which is less than minReplicas of %d", len(objs), defaultEvictorArgs.MinReplicas)
 			}
 
 			return nil
@@ -188,22 +219,22 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 	}
 
 	if defaultEvictorArgs.MinPodAge != nil {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			if pod.Status.StartTime == nil || time.Since(pod.Status.StartTime.Time) < defaultEvictorArgs.MinPodAge.Duration {
-				return fmt.Errorf("pod age is not older than MinPodAge: %s seconds", defaultEvictorArgs.MinPodAge.String())
+				return newReason(ReasonMinPodAge, "pod age is not older than MinPodAge: %s seconds", defaultEvictorArgs.MinPodAge.String())
 			}
 			return nil
 		})
 	}
 
 	if defaultEvictorArgs.IgnorePodsWithoutPDB {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+		ev.constraints = append(ev.constraints, func(pod *v1.Pod) *frameworktypes.FilterReason {
 			hasPdb, err := utils.IsPodCoveredByPDB(pod, handle.SharedInformerFactory().Policy().V1().PodDisruptionBudgets().Lister())
 			if err != nil {
-				return fmt.Errorf("unable to check if pod is covered by PodDisruptionBudget: %w", err)
+				return newReason(ReasonNoPodDisruptionBudget, "unable to check if pod is covered by PodDisruptionBudget: %v", err)
 			}
 			if !hasPdb {
-				return fmt.Errorf("no PodDisruptionBudget found for pod")
+				return newReason(ReasonNoPodDisruptionBudget, "no PodDisruptionBudget found for pod")
 			}
 			return nil
 		})
@@ -217,78 +248,67 @@ func (d *DefaultEvictor) Name() string {
 	return PluginName
 }
 
-func (d *DefaultEvictor) PreEvictionFilter(pod *v1.Pod) bool {
+func (d *DefaultEvictor) PreEvictionFilter(pod *v1.Pod) *frameworktypes.FilterVerdict {
 	if d.args.NodeFit {
 		nodes, err := nodeutil.ReadyNodes(context.TODO(), d.handle.ClientSet(), d.handle.SharedInformerFactory().Core().V1().Nodes().Lister(), d.args.NodeSelector)
 		if err != nil {
 			klog.ErrorS(err, "unable to list ready nodes", "pod", klog.KObj(pod))
-			return false
+			return frameworktypes.NewFilterVerdict(PluginName, frameworktypes.FilterReason{Code: ReasonNodeFit, Message: fmt.Sprintf("unable to list ready nodes: %v", err)})
 		}
 		if !nodeutil.PodFitsAnyOtherNode(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes) {
 			klog.InfoS("pod does not fit on any other node because of nodeSelector(s), Taint(s), or nodes marked as unschedulable", "pod", klog.KObj(pod))
-			return false
+			return frameworktypes.NewFilterVerdict(PluginName, frameworktypes.FilterReason{Code: ReasonNodeFit, Message: "pod does not fit on any other node because of nodeSelector(s), Taint(s), or nodes marked as unschedulable"})
 		}
-		return true
+		return nil
 	}
-	return true
+	return nil
 }
 
-func (d *DefaultEvictor) Filter(pod *v1.Pod) bool {
-	checkErrs := []error{}
+func (d *DefaultEvictor) Filter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	reasons := []frameworktypes.FilterReason{}
 
 	if HaveEvictAnnotation(pod) {
-		return true
+		return nil
 	}
 
 	if utils.IsMirrorPod(pod) {
-		checkErrs = append(checkErrs, fmt.Errorf("pod is a mirror pod"))
+		reasons = append(reasons, frameworktypes.FilterReason{Code: ReasonMirrorPod, Message: "pod is a mirror pod"})
 	}
 
 	if utils.IsStaticPod(pod) {
-		checkErrs = append(checkErrs, fmt.Errorf("pod is a static pod"))
+		reasons = append(reasons, frameworktypes.FilterReason{Code: ReasonStaticPod, Message: "pod is a static pod"})
 	}
 
 	if utils.IsPodTerminating(pod) {
-		checkErrs = append(checkErrs, fmt.Errorf("pod is terminating"))
+		reasons = append(reasons, frameworktypes.FilterReason{Code: ReasonTerminating, Message: "pod is terminating"})
 	}
 
 	for _, c := range d.constraints {
-		if err := c(pod); err != nil {
-			checkErrs = append(checkErrs, err)
+		if reason := c(pod); reason != nil {
+			reasons = append(reasons, *reason)
 		}
 	}
 
-	if len(checkErrs) > 0 {
-		klog.V(4).InfoS("Pod fails the following checks", "pod", klog.KObj(pod), "checks", utilerrors.NewAggregate(checkErrs).Error())
-		return false
+	if len(reasons) > 0 {
+		verdict := frameworktypes.NewFilterVerdict(PluginName, reasons...)
+		klog.V(4).InfoS("Pod fails the following checks", "pod", klog.KObj(pod), "checks", verdict.String())
+		return verdict
 	}
 
-	return true
+	return nil
 }
 
-func getPodIndexerByOwnerRefs(indexName string, handle frameworktypes.Handle) (cache.Indexer, error) {
-	podInformer := handle.SharedInformerFactory().Core().V1().Pods().Informer()
-	indexer := podInformer.GetIndexer()
-
-	// do not reinitialize the indexer, if it's been defined already
-	for name := range indexer.GetIndexers() {
-		if name == indexName {
-			return indexer, nil
-		}
-	}
-
-	if err := podInformer.AddIndexers(cache.Indexers{
-		indexName: func(obj interface{}) ([]string, error) {
-			pod, ok := obj.(*v1.Pod)
-			if !ok {
-				return []string{}, errors.New("unexpected object")
-			}
-
-			return podutil.OwnerRefUIDs(pod), nil
-		},
-	}); err != nil {
-		return nil, err
+// RequiredResources lists the resources the plugin reads through the informers and the client set
+func (d *DefaultEvictor) RequiredResources() []schema.GroupVersionResource {
+	return []schema.GroupVersionResource{
+		v1.SchemeGroupVersion.WithResource("pods"),
+		v1.SchemeGroupVersion.WithResource("nodes"),
+		v1.SchemeGroupVersion.WithResource("namespaces"),
+		schedulingv1.SchemeGroupVersion.WithResource("priorityclasses"),
+		policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"),
 	}
+}
 
-	return indexer, nil
+func getPodIndexerByOwnerRefs(indexName string, handle frameworktypes.Handle) (cache.Indexer, error) {
+	return podutil.GetPodIndexerByOwnerRefs(indexName, handle.SharedInformerFactory().Core().V1().Pods().Informer())
 }
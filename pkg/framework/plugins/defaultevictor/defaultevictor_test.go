@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "k8s.io/api/core/v1"
@@ -50,6 +51,7 @@ type testCase struct {
 	minReplicas             uint
 	minPodAge               *metav1.Duration
 	result                  bool
+	reasons                 []frameworktypes.FilterReasonCode
 	ignorePodsWithoutPDB    bool
 }
 
@@ -94,6 +96,7 @@ func TestDefaultEvictorPreEvictionFilter(t *testing.T) {
 			evictSystemCriticalPods: false,
 			nodeFit:                 true,
 			result:                  false,
+			reasons:                 []frameworktypes.FilterReasonCode{ReasonNodeFit},
 		}, {
 			description: "Pod with correct tolerations running on normal node, all other nodes tainted",
 			pods: []*v1.Pod{
@@ -321,10 +324,22 @@ func TestDefaultEvictorPreEvictionFilter(t *testing.T) {
 				t.Fatalf("Unable to initialize the plugin: %v", err)
 			}
 
-			result := evictorPlugin.(frameworktypes.EvictorPlugin).PreEvictionFilter(test.pods[0])
-			if (result) != test.result {
+			verdict := evictorPlugin.(frameworktypes.EvictorPlugin).PreEvictionFilter(test.pods[0])
+			if result := verdict.Allowed(); result != test.result {
 				t.Errorf("Filter should return for pod %s %t, but it returns %t", test.pods[0].Name, test.result, result)
 			}
+			if test.reasons != nil {
+				reasons := []frameworktypes.FilterReasonCode{}
+				for _, reason := range verdict.Reasons {
+					reasons = append(reasons, reason.Code)
+				}
+				if diff := cmp.Diff(test.reasons, reasons); diff != "" {
+					t.Errorf("Unexpected verdict reasons (-want, +got):\n%s", diff)
+				}
+				if verdict.PluginName != PluginName {
+					t.Errorf("Expected verdict of %q plugin, got %q", PluginName, verdict.PluginName)
+				}
+			}
 		})
 	}
 }
@@ -351,6 +366,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			},
 			evictFailedBarePods: false,
 			result:              false,
+			reasons:             []frameworktypes.FilterReasonCode{ReasonNoOwnerReferences},
 		}, {
 			description:         "Normal pod eviction with no ownerRefs and evictFailedBarePods enabled",
 			pods:                []*v1.Pod{test.BuildTestPod("bare_pod", 400, 0, n1.Name, nil)},
@@ -449,6 +465,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			evictLocalStoragePods:   false,
 			evictSystemCriticalPods: false,
 			result:                  false,
+			reasons:                 []frameworktypes.FilterReasonCode{ReasonLocalStorage},
 		}, {
 			description: "Pod is evicted because it is bound to a PV and evictLocalStoragePods = true",
 			pods: []*v1.Pod{
@@ -503,6 +520,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			evictLocalStoragePods:   false,
 			evictSystemCriticalPods: false,
 			result:                  false,
+			reasons:                 []frameworktypes.FilterReasonCode{ReasonDaemonSetPod},
 		}, {
 			description: "Pod is evicted because it is part of a daemonSet, but it has scheduler.alpha.kubernetes.io/evict annotation",
 			pods: []*v1.Pod{
@@ -525,6 +543,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			evictLocalStoragePods:   false,
 			evictSystemCriticalPods: false,
 			result:                  false,
+			reasons:                 []frameworktypes.FilterReasonCode{ReasonMirrorPod},
 		}, {
 			description: "Pod is evicted because it is a mirror pod, but it has scheduler.alpha.kubernetes.io/evict annotation",
 			pods: []*v1.Pod{
@@ -549,6 +568,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			evictLocalStoragePods:   false,
 			evictSystemCriticalPods: false,
 			result:                  false,
+			reasons:                 []frameworktypes.FilterReasonCode{ReasonSystemCriticalPriority},
 		}, {
 			description: "Pod is evicted because it has system critical priority, but it has scheduler.alpha.kubernetes.io/evict annotation",
 			pods: []*v1.Pod{
@@ -576,6 +596,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			evictSystemCriticalPods: false,
 			priorityThreshold:       &lowPriority,
 			result:                  false,
+			reasons:                 []frameworktypes.FilterReasonCode{ReasonPriorityThreshold},
 		}, {
 			description: "Pod is evicted because it has a priority higher than the configured priority threshold, but it has scheduler.alpha.kubernetes.io/evict annotation",
 			pods: []*v1.Pod{
@@ -698,6 +719,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			},
 			minReplicas: 3,
 			result:      false,
+			reasons:     []frameworktypes.FilterReasonCode{ReasonMinReplicas},
 		}, {
 			description: "minReplicas of 2, multiple owners, no eviction",
 			pods: []*v1.Pod{
@@ -722,6 +744,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			},
 			minPodAge: &minPodAge,
 			result:    false,
+			reasons:   []frameworktypes.FilterReasonCode{ReasonMinPodAge},
 		}, {
 			description: "minPodAge of 50, pod created 60 minutes ago, evicts",
 			pods: []*v1.Pod{
@@ -755,6 +778,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			},
 			ignorePodsWithoutPDB: true,
 			result:               false,
+			reasons:              []frameworktypes.FilterReasonCode{ReasonNoPodDisruptionBudget},
 		}, {
 			description: "ignorePodsWithoutPDB, pod with PDBs, evicts",
 			pods: []*v1.Pod{
@@ -786,6 +810,7 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			},
 			ignorePvcPods: true,
 			result:        false,
+			reasons:       []frameworktypes.FilterReasonCode{ReasonPVC},
 		}, {
 			description: "ignorePvcPods is not set, pod with PVC, evicts",
 			pods: []*v1.Pod{
@@ -815,10 +840,22 @@ func TestDefaultEvictorFilter(t *testing.T) {
 				t.Fatalf("Unable to initialize the plugin: %v", err)
 			}
 
-			result := evictorPlugin.(frameworktypes.EvictorPlugin).Filter(test.pods[0])
-			if (result) != test.result {
+			verdict := evictorPlugin.(frameworktypes.EvictorPlugin).Filter(test.pods[0])
+			if result := verdict.Allowed(); result != test.result {
 				t.Errorf("Filter should return for pod %s %t, but it returns %t", test.pods[0].Name, test.result, result)
 			}
+			if test.reasons != nil {
+				reasons := []frameworktypes.FilterReasonCode{}
+				for _, reason := range verdict.Reasons {
+					reasons = append(reasons, reason.Code)
+				}
+				if diff := cmp.Diff(test.reasons, reasons); diff != "" {
+					t.Errorf("Unexpected verdict reasons (-want, +got):\n%s", diff)
+				}
+				if verdict.PluginName != PluginName {
+					t.Errorf("Expected verdict of %q plugin, got %q", PluginName, verdict.PluginName)
+				}
+			}
 		})
 	}
 }
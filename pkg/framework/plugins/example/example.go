@@ -169,6 +169,11 @@ func (d *Example) Deschedule(ctx context.Context, nodes []*v1.Node) *fwtypes.Sta
 		podsToEvict = append(podsToEvict, pods...)
 	}
 
+	// let the pre-sort and sort plugins configured in the profile decide
+	// the order in which the pods are evicted.
+	d.handle.Evictor().PreSort(podsToEvict)
+	d.handle.Evictor().Sort(podsToEvict)
+
 	// evict all the pods.
 	for _, pod := range podsToEvict {
 		logger.Info("Example plugin evicting pod", "pod", klog.KObj(pod))
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+const (
+	PluginName = "External"
+
+	// ReasonSidecarFailure is the reason code of pods rejected because the sidecar could not be reached
+	ReasonSidecarFailure frameworktypes.FilterReasonCode = "SidecarFailure"
+)
+
+var (
+	_ frameworktypes.DeschedulePlugin = &External{}
+	_ frameworktypes.BalancePlugin    = &External{}
+	_ frameworktypes.EvictorPlugin    = &External{}
+	_ frameworktypes.LifecyclePlugin  = &External{}
+)
+
+// External proxies the Deschedule, Balance, Filter and PreEvictionFilter extension points
+// to a sidecar process serving the ExternalPlugin gRPC service over a Unix socket.
+// Pods the sidecar asks to evict are evicted through the evictor, so all the eviction
+// limits of the descheduler still apply.
+type External struct {
+	handle    frameworktypes.Handle
+	args      *ExternalArgs
+	podFilter podutil.FilterFunc
+	conn      *grpc.ClientConn
+	client    *externalPluginClient
+}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	externalArgs, ok := args.(*ExternalArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type ExternalArgs, got %T", args)
+	}
+
+	var includedNamespaces, excludedNamespaces sets.Set[string]
+	if externalArgs.Namespaces != nil {
+		includedNamespaces = sets.New(externalArgs.Namespaces.Include...)
+		excludedNamespaces = sets.New(externalArgs.Namespaces.Exclude...)
+	}
+
+	podFilter, err := podutil.NewOptions().
+		WithFilter(handle.Evictor().Filter).
+		WithNamespaces(includedNamespaces).
+		WithoutNamespaces(excludedNamespaces).
+		WithLabelSelector(externalArgs.LabelSelector).
+		BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	conn, err := grpc.NewClient("unix:"+externalArgs.Socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a connection to %q: %v", externalArgs.Socket, err)
+	}
+
+	return &External{
+		handle:    handle,
+		args:      externalArgs,
+		podFilter: podFilter,
+		conn:      conn,
+		client:    &externalPluginClient{conn: conn},
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *External) Name() string {
+	return PluginName
+}
+
+// Init starts connecting to the sidecar so the first cycle does not have to wait for it
+func (d *External) Init(ctx context.Context) error {
+	d.conn.Connect()
+	return nil
+}
+
+// BeforeCycle is a no-op, the sidecar keeps its own state across cycles
+func (d *External) BeforeCycle(ctx context.Context) error {
+	return nil
+}
+
+// AfterCycle is a no-op, the sidecar keeps its own state across cycles
+func (d *External) AfterCycle(ctx context.Context) {}
+
+// Close closes the connection to the sidecar
+func (d *External) Close() error {
+	return d.conn.Close()
+}
+
+// Deschedule extension point implementation for the plugin
+func (d *External) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	return d.run(ctx, frameworktypes.DescheduleExtensionPoint, nodes, d.client.Deschedule)
+}
+
+// Balance extension point implementation for the plugin
+func (d *External) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	return d.run(ctx, frameworktypes.BalanceExtensionPoint, nodes, d.client.Balance)
+}
+
+func (d *External) run(ctx context.Context, extensionPoint frameworktypes.ExtensionPoint, nodes []*v1.Node, call func(context.Context, *ExtensionPointRequest) (*ExtensionPointResponse, error)) *frameworktypes.Status {
+	pods, err := podutil.ListPodsOnNodes(nodes, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: fmt.Errorf("error listing all pods: %v", err),
+		}
+	}
+	// the sidecar receives the pods in the order given by the pre-sort plugins
+	d.handle.Evictor().PreSort(pods)
+
+	req := &ExtensionPointRequest{
+		Nodes: make([]*v1.Node, 0, len(nodes)),
+		Pods:  make([]*v1.Pod, 0, len(pods)),
+	}
+	for _, node := range nodes {
+		node = node.DeepCopy()
+		node.ManagedFields = nil
+		req.Nodes = append(req.Nodes, node)
+	}
+	podsByKey := make(map[types.NamespacedName]*v1.Pod, len(pods))
+	for _, pod := range pods {
+		podsByKey[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = pod
+		req.Pods = append(req.Pods, withoutManagedFields(pod))
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, d.args.Timeout.Duration)
+	defer cancel()
+	resp, err := call(callCtx, req)
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: fmt.Errorf("%s call to sidecar at %q failed: %v", extensionPoint, d.args.Socket, err),
+		}
+	}
+
+	podsToEvict := make([]*v1.Pod, 0, len(resp.Evictions))
+	reasons := make(map[*v1.Pod]string, len(resp.Evictions))
+	for _, eviction := range resp.Evictions {
+		pod, ok := podsByKey[types.NamespacedName{Namespace: eviction.Namespace, Name: eviction.Name}]
+		if !ok {
+			klog.V(2).InfoS("Ignoring eviction request of a pod not sent to the sidecar", "pod", klog.KRef(eviction.Namespace, eviction.Name), "extensionPoint", extensionPoint)
+			continue
+		}
+		if _, ok := reasons[pod]; ok {
+			continue
+		}
+		podsToEvict = append(podsToEvict, pod)
+		reasons[pod] = eviction.Reason
+	}
+
+	// The order of the eviction requests returned by the sidecar is kept unless sort plugins are configured
+	d.handle.Evictor().Sort(podsToEvict)
+
+loop:
+	for _, pod := range podsToEvict {
+		if !d.handle.Evictor().PreEvictionFilter(pod) {
+			continue
+		}
+		err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName, Reason: reasons[pod]})
+		if err == nil {
+			continue
+		}
+		if evictions.IsEvictionStopError(err) {
+			return nil
+		}
+		switch err.(type) {
+		case *evictions.EvictionNodeLimitError:
+			continue loop
+		default:
+			evictions.LogEvictionError(pod, err)
+		}
+	}
+
+	return nil
+}
+
+// Filter extension point implementation for the plugin
+func (d *External) Filter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	return d.filter(frameworktypes.FilterExtensionPoint, pod, d.client.Filter)
+}
+
+// PreEvictionFilter extension point implementation for the plugin
+func (d *External) PreEvictionFilter(pod *v1.Pod) *frameworktypes.FilterVerdict {
+	return d.filter(frameworktypes.PreEvictionFilterExtensionPoint, pod, d.client.PreEvictionFilter)
+}
+
+func (d *External) filter(extensionPoint frameworktypes.ExtensionPoint, pod *v1.Pod, call func(context.Context, *FilterRequest) (*FilterResponse, error)) *frameworktypes.FilterVerdict {
+	ctx, cancel := context.WithTimeout(context.TODO(), d.args.Timeout.Duration)
+	defer cancel()
+	resp, err := call(ctx, &FilterRequest{Pod: withoutManagedFields(pod)})
+	if err != nil {
+		// a pod is never evicted without the sidecar approving it
+		klog.ErrorS(err, "sidecar call failed", "extensionPoint", extensionPoint, "socket", d.args.Socket, "pod", klog.KObj(pod))
+		return frameworktypes.NewFilterVerdict(PluginName, frameworktypes.FilterReason{
+			Code:    ReasonSidecarFailure,
+			Message: fmt.Sprintf("%s call to sidecar at %q failed: %v", extensionPoint, d.args.Socket, err),
+		})
+	}
+	if len(resp.Reasons) == 0 {
+		return nil
+	}
+	verdict := frameworktypes.NewFilterVerdict(PluginName)
+	for _, reason := range resp.Reasons {
+		verdict.Reasons = append(verdict.Reasons, frameworktypes.FilterReason{Code: frameworktypes.FilterReasonCode(reason.Code), Message: reason.Message})
+	}
+	return verdict
+}
+
+func withoutManagedFields(pod *v1.Pod) *v1.Pod {
+	if len(pod.ManagedFields) == 0 {
+		return pod
+	}
+	pod = pod.DeepCopy()
+	pod.ManagedFields = nil
+	return pod
+}
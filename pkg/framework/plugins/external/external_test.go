@@ -0,0 +1,343 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/encoding"
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func buildTestPod(name, nodeName string, apply func(pod *v1.Pod)) *v1.Pod {
+	return test.BuildTestPod(name, 100, 0, nodeName, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+		if apply != nil {
+			apply(pod)
+		}
+	})
+}
+
+func annotated(key string) func(pod *v1.Pod) {
+	return func(pod *v1.Pod) {
+		pod.Annotations = map[string]string{key: "true"}
+	}
+}
+
+func TestExternalDeschedule(t *testing.T) {
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+
+	testCases := []struct {
+		description         string
+		pods                []*v1.Pod
+		args                *ExternalArgs
+		extraEvictions      []EvictionRequest
+		maxPodsToEvictTotal *uint
+		expectedEvictedPods []string
+		expectedSentPods    []string
+	}{
+		{
+			description: "annotated pods are evicted",
+			pods: []*v1.Pod{
+				buildTestPod("p1", n1.Name, annotated(evictAnnotationKey)),
+				buildTestPod("p2", n1.Name, nil),
+				buildTestPod("p3", n2.Name, annotated(evictAnnotationKey)),
+			},
+			args:                &ExternalArgs{},
+			expectedEvictedPods: []string{"p1", "p3"},
+			expectedSentPods:    []string{"p1", "p2", "p3"},
+		},
+		{
+			description: "pods rejected by the evictor are not sent to the sidecar",
+			pods: []*v1.Pod{
+				buildTestPod("p1", n1.Name, annotated(evictAnnotationKey)),
+				test.BuildTestPod("p2", 100, 0, n1.Name, annotated(evictAnnotationKey)),
+			},
+			args:                &ExternalArgs{},
+			expectedEvictedPods: []string{"p1"},
+			expectedSentPods:    []string{"p1"},
+		},
+		{
+			description: "pods not sent to the sidecar are never evicted",
+			pods: []*v1.Pod{
+				buildTestPod("p1", n1.Name, annotated(evictAnnotationKey)),
+				buildTestPod("p2", n1.Name, func(pod *v1.Pod) { pod.Namespace = "kube-system" }),
+			},
+			args: &ExternalArgs{
+				Namespaces: &api.Namespaces{Exclude: []string{"kube-system"}},
+			},
+			extraEvictions:      []EvictionRequest{{Namespace: "kube-system", Name: "p2"}, {Namespace: "default", Name: "unknown"}},
+			expectedEvictedPods: []string{"p1"},
+			expectedSentPods:    []string{"p1"},
+		},
+		{
+			description: "eviction limits apply",
+			pods: []*v1.Pod{
+				buildTestPod("p1", n1.Name, annotated(evictAnnotationKey)),
+				buildTestPod("p2", n1.Name, annotated(evictAnnotationKey)),
+				buildTestPod("p3", n2.Name, annotated(evictAnnotationKey)),
+			},
+			args:                &ExternalArgs{},
+			maxPodsToEvictTotal: ptr.To[uint](2),
+			expectedEvictedPods: []string{"p1", "p2"},
+			expectedSentPods:    []string{"p1", "p2", "p3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sidecar := &referenceSidecar{extraEvictions: tc.extraEvictions}
+			tc.args.Socket = startReferenceSidecar(t, sidecar)
+			SetDefaults_ExternalArgs(tc.args)
+
+			objs := []runtime.Object{n1, n2}
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			var evictedPods []string
+			fakeClient.PrependReactor("create", "pods", podEvictionReactionFunc(&evictedPods))
+
+			handle, _, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				fakeClient,
+				evictions.NewOptions().WithMaxPodsToEvictTotal(tc.maxPodsToEvictTotal),
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			plugin, err := New(tc.args, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			status := plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{n1, n2})
+			if status != nil && status.Err != nil {
+				t.Fatalf("Unexpected error: %v", status.Err)
+			}
+
+			// the sidecar requests the evictions in the order the pods are sent
+			sort.Strings(evictedPods)
+			if diff := cmp.Diff(tc.expectedEvictedPods, evictedPods); diff != "" {
+				t.Errorf("Unexpected evicted pods (-want,+got):\n%s", diff)
+			}
+
+			if len(sidecar.requests) != 1 {
+				t.Fatalf("Expected a single request sent to the sidecar, got %v", len(sidecar.requests))
+			}
+			var sentPods []string
+			for _, pod := range sidecar.requests[0].Pods {
+				sentPods = append(sentPods, pod.Name)
+			}
+			// pods of a node are listed in no particular order
+			sort.Strings(sentPods)
+			if diff := cmp.Diff(tc.expectedSentPods, sentPods); diff != "" {
+				t.Errorf("Unexpected pods sent to the sidecar (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExternalBalance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	pods := []*v1.Pod{
+		buildTestPod("p1", n1.Name, nil),
+		buildTestPod("p2", n1.Name, nil),
+		buildTestPod("p3", n1.Name, nil),
+		buildTestPod("p4", n2.Name, nil),
+	}
+
+	objs := []runtime.Object{n1, n2}
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	var evictedPods []string
+	fakeClient.PrependReactor("create", "pods", podEvictionReactionFunc(&evictedPods))
+
+	handle, _, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	args := &ExternalArgs{Socket: startReferenceSidecar(t, &referenceSidecar{})}
+	SetDefaults_ExternalArgs(args)
+	plugin, err := New(args, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+
+	status := plugin.(frameworktypes.BalancePlugin).Balance(ctx, []*v1.Node{n1, n2})
+	if status != nil && status.Err != nil {
+		t.Fatalf("Unexpected error: %v", status.Err)
+	}
+	if len(evictedPods) != 1 || pods[0].Name != evictedPods[0] {
+		t.Errorf("Expected %v to be evicted, got %v", pods[0].Name, evictedPods)
+	}
+}
+
+func TestExternalFilter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakeClient := fake.NewSimpleClientset()
+	handle, _, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	args := &ExternalArgs{Socket: startReferenceSidecar(t, &referenceSidecar{})}
+	SetDefaults_ExternalArgs(args)
+	plugin, err := New(args, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+	evictorPlugin := plugin.(frameworktypes.EvictorPlugin)
+
+	testCases := []struct {
+		description                      string
+		pod                              *v1.Pod
+		expectedFilterReasons            []frameworktypes.FilterReason
+		expectedPreEvictionFilterReasons []frameworktypes.FilterReason
+	}{
+		{
+			description: "pod allowed",
+			pod:         buildTestPod("p1", "n1", nil),
+		},
+		{
+			description: "protected pod rejected by filter",
+			pod: buildTestPod("p2", "n1", func(pod *v1.Pod) {
+				pod.Labels = map[string]string{protectedLabelKey: "true"}
+			}),
+			expectedFilterReasons: []frameworktypes.FilterReason{{Code: "Protected", Message: "pod is protected"}},
+		},
+		{
+			description:                      "pinned pod rejected by preEvictionFilter",
+			pod:                              buildTestPod("p3", "n1", annotated(pinnedAnnotationKey)),
+			expectedPreEvictionFilterReasons: []frameworktypes.FilterReason{{Code: "Pinned", Message: "pod is pinned to its node"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			verdict := evictorPlugin.Filter(tc.pod)
+			if diff := cmp.Diff(tc.expectedFilterReasons, reasonsOf(verdict)); diff != "" {
+				t.Errorf("Unexpected filter reasons (-want,+got):\n%s", diff)
+			}
+			verdict = evictorPlugin.PreEvictionFilter(tc.pod)
+			if diff := cmp.Diff(tc.expectedPreEvictionFilterReasons, reasonsOf(verdict)); diff != "" {
+				t.Errorf("Unexpected preEvictionFilter reasons (-want,+got):\n%s", diff)
+			}
+			if !verdict.Allowed() && verdict.PluginName != PluginName {
+				t.Errorf("Expected verdict of %q plugin, got %q", PluginName, verdict.PluginName)
+			}
+		})
+	}
+}
+
+func TestExternalSidecarUnavailable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	p1 := buildTestPod("p1", n1.Name, annotated(evictAnnotationKey))
+	fakeClient := fake.NewSimpleClientset(n1, p1)
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	plugin, err := New(&ExternalArgs{
+		Socket:  filepath.Join(t.TempDir(), "missing.sock"),
+		Timeout: &metav1.Duration{Duration: time.Second},
+	}, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+
+	status := plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{n1})
+	if status == nil || status.Err == nil {
+		t.Errorf("Expected the deschedule extension point to fail")
+	}
+	if podEvictor.TotalEvicted() != 0 {
+		t.Errorf("Expected no pod to be evicted, got %v", podEvictor.TotalEvicted())
+	}
+
+	verdict := plugin.(frameworktypes.EvictorPlugin).Filter(p1)
+	if verdict.Allowed() || verdict.Reasons[0].Code != ReasonSidecarFailure {
+		t.Errorf("Expected the pod to be rejected with %q reason, got %v", ReasonSidecarFailure, verdict)
+	}
+}
+
+func podEvictionReactionFunc(evictedPods *[]string) func(action core.Action) (bool, runtime.Object, error) {
+	return func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			createAct, matched := action.(core.CreateActionImpl)
+			if !matched {
+				return false, nil, fmt.Errorf("unable to convert action to core.CreateActionImpl")
+			}
+			if eviction, matched := createAct.Object.(*policy.Eviction); matched {
+				*evictedPods = append(*evictedPods, eviction.GetName())
+			}
+		}
+		return false, nil, nil // fallback to the default reactor
+	}
+}
+
+func reasonsOf(verdict *frameworktypes.FilterVerdict) []frameworktypes.FilterReason {
+	if verdict.Allowed() {
+		return nil
+	}
+	return verdict.Reasons
+}
+
+func TestExternalCodec(t *testing.T) {
+	if _, ok := encoding.GetCodec(CodecName).(jsonCodec); !ok {
+		t.Errorf("Expected the messages to be encoded by the codec registered as %q", CodecName)
+	}
+	if _, ok := encoding.GetCodec("json").(jsonCodec); ok {
+		t.Errorf("Expected the json codec of the process not to be replaced")
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// pods annotated with this key are evicted by the reference sidecar on the Deschedule extension point
+	evictAnnotationKey = "external.descheduler.io/evict"
+	// pods labeled with this key are rejected by the reference sidecar on the Filter extension point
+	protectedLabelKey = "external.descheduler.io/protected"
+	// pods annotated with this key are rejected by the reference sidecar on the PreEvictionFilter extension point
+	pinnedAnnotationKey = "external.descheduler.io/pinned"
+)
+
+// referenceSidecar is a minimal implementation of the ExternalPlugin service
+// showing what a sidecar is expected to do on each of the extension points.
+type referenceSidecar struct {
+	lock sync.Mutex
+	// extraEvictions are returned on top of the computed eviction requests
+	extraEvictions []EvictionRequest
+	// requests received on the Deschedule and Balance extension points
+	requests []*ExtensionPointRequest
+}
+
+var _ ExternalPluginServer = &referenceSidecar{}
+
+// Deschedule asks to evict all the annotated pods
+func (s *referenceSidecar) Deschedule(ctx context.Context, req *ExtensionPointRequest) (*ExtensionPointResponse, error) {
+	s.record(req)
+	resp := &ExtensionPointResponse{}
+	for _, pod := range req.Pods {
+		if pod.Annotations[evictAnnotationKey] == "true" {
+			resp.Evictions = append(resp.Evictions, EvictionRequest{Namespace: pod.Namespace, Name: pod.Name, Reason: "annotated for eviction"})
+		}
+	}
+	resp.Evictions = append(resp.Evictions, s.extraEvictions...)
+	return resp, nil
+}
+
+// Balance asks to evict a single pod from the most loaded node
+// when it runs at least two more pods than the least loaded node
+func (s *referenceSidecar) Balance(ctx context.Context, req *ExtensionPointRequest) (*ExtensionPointResponse, error) {
+	s.record(req)
+	resp := &ExtensionPointResponse{}
+	if len(req.Nodes) < 2 {
+		return resp, nil
+	}
+	podsPerNode := map[string][]*v1.Pod{}
+	for _, pod := range req.Pods {
+		podsPerNode[pod.Spec.NodeName] = append(podsPerNode[pod.Spec.NodeName], pod)
+	}
+	most, least := req.Nodes[0].Name, req.Nodes[0].Name
+	for _, node := range req.Nodes {
+		if len(podsPerNode[node.Name]) > len(podsPerNode[most]) {
+			most = node.Name
+		}
+		if len(podsPerNode[node.Name]) < len(podsPerNode[least]) {
+			least = node.Name
+		}
+	}
+	if len(podsPerNode[most])-len(podsPerNode[least]) >= 2 {
+		// pods of a node are listed in no particular order
+		candidates := podsPerNode[most]
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].Name < candidates[j].Name
+		})
+		pod := candidates[0]
+		resp.Evictions = append(resp.Evictions, EvictionRequest{Namespace: pod.Namespace, Name: pod.Name, Reason: "node " + most + " is overloaded"})
+	}
+	return resp, nil
+}
+
+// Filter rejects protected pods
+func (s *referenceSidecar) Filter(ctx context.Context, req *FilterRequest) (*FilterResponse, error) {
+	if req.Pod.Labels[protectedLabelKey] == "true" {
+		return &FilterResponse{Reasons: []FilterReason{{Code: "Protected", Message: "pod is protected"}}}, nil
+	}
+	return &FilterResponse{}, nil
+}
+
+// PreEvictionFilter rejects pinned pods
+func (s *referenceSidecar) PreEvictionFilter(ctx context.Context, req *FilterRequest) (*FilterResponse, error) {
+	if req.Pod.Annotations[pinnedAnnotationKey] == "true" {
+		return &FilterResponse{Reasons: []FilterReason{{Code: "Pinned", Message: "pod is pinned to its node"}}}, nil
+	}
+	return &FilterResponse{}, nil
+}
+
+func (s *referenceSidecar) record(req *ExtensionPointRequest) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.requests = append(s.requests, req)
+}
+
+// startReferenceSidecar serves the sidecar on a Unix socket until the test finishes
+func startReferenceSidecar(t *testing.T, sidecar *referenceSidecar) string {
+	t.Helper()
+	// Unix socket paths are limited in length, t.TempDir() can be too long
+	dir, err := os.MkdirTemp("", "external")
+	if err != nil {
+		t.Fatalf("Unable to create a socket directory: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "sidecar.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("Unable to listen on %q: %v", socket, err)
+	}
+
+	server := grpc.NewServer()
+	RegisterExternalPluginServer(server, sidecar)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return socket
+}
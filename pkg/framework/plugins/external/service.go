@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	v1 "k8s.io/api/core/v1"
+)
+
+// The ExternalPlugin gRPC service is described by Go types instead of
+// protobuf messages. The messages are exchanged as JSON so sidecars
+// can reuse the Kubernetes API types when decoding nodes and pods.
+const (
+	// ServiceName is the fully qualified name of the ExternalPlugin gRPC service
+	ServiceName = "descheduler.external.v1alpha1.ExternalPlugin"
+	// CodecName is the gRPC content subtype the messages are encoded with. The codecs are
+	// registered for the whole process, a name of its own leaves the "json" codec of other
+	// gRPC clients and servers alone.
+	CodecName = "descheduler-external-json"
+)
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+// ExtensionPointRequest is sent to the sidecar on the Deschedule and Balance extension points
+type ExtensionPointRequest struct {
+	// Nodes the plugin is invoked with
+	Nodes []*v1.Node `json:"nodes"`
+	// Pods assigned to the nodes which passed the Filter extension point of the profile
+	Pods []*v1.Pod `json:"pods"`
+}
+
+// ExtensionPointResponse carries the pods the sidecar asks to evict
+type ExtensionPointResponse struct {
+	Evictions []EvictionRequest `json:"evictions,omitempty"`
+}
+
+// EvictionRequest identifies a pod to be evicted
+type EvictionRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Reason is recorded in the eviction event and logs
+	Reason string `json:"reason,omitempty"`
+}
+
+// FilterRequest is sent to the sidecar on the Filter and PreEvictionFilter extension points
+type FilterRequest struct {
+	Pod *v1.Pod `json:"pod"`
+}
+
+// FilterResponse rejects the pod when at least one reason is returned
+type FilterResponse struct {
+	Reasons []FilterReason `json:"reasons,omitempty"`
+}
+
+// FilterReason describes why the sidecar rejected a pod
+type FilterReason struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// ExternalPluginServer is the server API for the ExternalPlugin service
+// implemented by sidecars.
+type ExternalPluginServer interface {
+	Deschedule(context.Context, *ExtensionPointRequest) (*ExtensionPointResponse, error)
+	Balance(context.Context, *ExtensionPointRequest) (*ExtensionPointResponse, error)
+	Filter(context.Context, *FilterRequest) (*FilterResponse, error)
+	PreEvictionFilter(context.Context, *FilterRequest) (*FilterResponse, error)
+}
+
+// RegisterExternalPluginServer registers the sidecar implementation of the ExternalPlugin service
+func RegisterExternalPluginServer(s grpc.ServiceRegistrar, srv ExternalPluginServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*ExternalPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deschedule",
+			Handler: unaryHandler("Deschedule", func(srv ExternalPluginServer, ctx context.Context, req *ExtensionPointRequest) (interface{}, error) {
+				return srv.Deschedule(ctx, req)
+			}),
+		},
+		{
+			MethodName: "Balance",
+			Handler: unaryHandler("Balance", func(srv ExternalPluginServer, ctx context.Context, req *ExtensionPointRequest) (interface{}, error) {
+				return srv.Balance(ctx, req)
+			}),
+		},
+		{
+			MethodName: "Filter",
+			Handler: unaryHandler("Filter", func(srv ExternalPluginServer, ctx context.Context, req *FilterRequest) (interface{}, error) {
+				return srv.Filter(ctx, req)
+			}),
+		},
+		{
+			MethodName: "PreEvictionFilter",
+			Handler: unaryHandler("PreEvictionFilter", func(srv ExternalPluginServer, ctx context.Context, req *FilterRequest) (interface{}, error) {
+				return srv.PreEvictionFilter(ctx, req)
+			}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func fullMethodName(method string) string {
+	return "/" + ServiceName + "/" + method
+}
+
+func unaryHandler[Req any](method string, call func(srv ExternalPluginServer, ctx context.Context, req *Req) (interface{}, error)) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := new(Req)
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(ExternalPluginServer), ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: fullMethodName(method),
+		}
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv.(ExternalPluginServer), ctx, req.(*Req))
+		})
+	}
+}
+
+// externalPluginClient is the client API for the ExternalPlugin service
+type externalPluginClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c *externalPluginClient) invoke(ctx context.Context, method string, req, resp interface{}) error {
+	return c.conn.Invoke(ctx, fullMethodName(method), req, resp, grpc.CallContentSubtype(CodecName))
+}
+
+func (c *externalPluginClient) Deschedule(ctx context.Context, req *ExtensionPointRequest) (*ExtensionPointResponse, error) {
+	resp := &ExtensionPointResponse{}
+	return resp, c.invoke(ctx, "Deschedule", req, resp)
+}
+
+func (c *externalPluginClient) Balance(ctx context.Context, req *ExtensionPointRequest) (*ExtensionPointResponse, error) {
+	resp := &ExtensionPointResponse{}
+	return resp, c.invoke(ctx, "Balance", req, resp)
+}
+
+func (c *externalPluginClient) Filter(ctx context.Context, req *FilterRequest) (*FilterResponse, error) {
+	resp := &FilterResponse{}
+	return resp, c.invoke(ctx, "Filter", req, resp)
+}
+
+func (c *externalPluginClient) PreEvictionFilter(ctx context.Context, req *FilterRequest) (*FilterResponse, error) {
+	resp := &FilterResponse{}
+	return resp, c.invoke(ctx, "PreEvictionFilter", req, resp)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExternalArgs holds arguments used to configure External plugin.
+type ExternalArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Socket is the path of the Unix socket the sidecar serves the ExternalPlugin gRPC service on
+	Socket string `json:"socket"`
+	// Timeout of a single call to the sidecar
+	Timeout       *metav1.Duration      `json:"timeout,omitempty"`
+	Namespaces    *api.Namespaces       `json:"namespaces,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
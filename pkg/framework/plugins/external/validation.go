@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// ValidateExternalArgs validates External arguments
+func ValidateExternalArgs(obj runtime.Object) error {
+	args := obj.(*ExternalArgs)
+	var errs []error
+
+	if args.Socket == "" {
+		errs = append(errs, fmt.Errorf("socket must be set"))
+	}
+
+	if args.Timeout != nil && args.Timeout.Duration <= 0 {
+		errs = append(errs, fmt.Errorf("timeout must be positive, got %v", args.Timeout.Duration))
+	}
+
+	// At most one of include/exclude can be set
+	if args.Namespaces != nil && len(args.Namespaces.Include) > 0 && len(args.Namespaces.Exclude) > 0 {
+		errs = append(errs, fmt.Errorf("only one of Include/Exclude namespaces can be set"))
+	}
+
+	if args.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(args.LabelSelector); err != nil {
+			errs = append(errs, fmt.Errorf("failed to get label selectors from strategy's params: %+v", err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestValidateExternalArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *ExternalArgs
+		expectError bool
+	}{
+		{
+			description: "valid arg, no errors",
+			args: &ExternalArgs{
+				Socket:  "/var/run/descheduler/sidecar.sock",
+				Timeout: &metav1.Duration{Duration: DefaultTimeout},
+			},
+			expectError: false,
+		},
+		{
+			description: "missing socket, expects errors",
+			args:        &ExternalArgs{},
+			expectError: true,
+		},
+		{
+			description: "non-positive timeout, expects errors",
+			args: &ExternalArgs{
+				Socket:  "/var/run/descheduler/sidecar.sock",
+				Timeout: &metav1.Duration{},
+			},
+			expectError: true,
+		},
+		{
+			description: "both include and exclude namespaces, expects errors",
+			args: &ExternalArgs{
+				Socket: "/var/run/descheduler/sidecar.sock",
+				Namespaces: &api.Namespaces{
+					Include: []string{"default"},
+					Exclude: []string{"kube-system"},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateExternalArgs(tc.args)
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Errorf("Unexpected validation result, expected error: %v, got: %v", tc.expectError, err)
+			}
+		})
+	}
+}
@@ -135,6 +135,11 @@ func (h *HighNodeUtilization) Name() string {
 // utilized nodes. The goal here is to concentrate pods in fewer nodes so that
 // less nodes are used.
 func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	// the handle may be updated between the descheduling cycles, e.g. with
+	// the fake informers of a dry run, so the pods are listed through its
+	// current state every time.
+	h.usageClient = newRequestedUsageClient(h.resourceNames, h.handle.GetPodsAssignedToNodeFunc())
+
 	if err := h.usageClient.sync(ctx, nodes); err != nil {
 		return &frameworktypes.Status{
 			Err: fmt.Errorf("error getting node usage: %v", err),
@@ -207,6 +212,7 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 	}
 
 	lowNodes, schedulableNodes := nodeInfos[0], nodeInfos[1]
+	classes := map[string][]NodeInfo{"underutilized": lowNodes, "schedulable": schedulableNodes}
 
 	klog.V(1).InfoS("Criteria for a node below target utilization", h.criteria...)
 	klog.V(1).InfoS("Number of underutilized nodes", "totalNumber", len(lowNodes))
@@ -215,7 +221,7 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 		klog.V(1).InfoS(
 			"No node is underutilized, nothing to do here, you might tune your thresholds further",
 		)
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	if len(lowNodes) <= h.args.NumberOfNodes {
@@ -224,17 +230,17 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 			"underutilizedNodes", len(lowNodes),
 			"numberOfNodes", h.args.NumberOfNodes,
 		)
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	if len(lowNodes) == len(nodes) {
 		klog.V(1).InfoS("All nodes are underutilized, nothing to do here")
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	if len(schedulableNodes) == 0 {
 		klog.V(1).InfoS("No node is available to schedule the pods, nothing to do here")
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	// stops the eviction process if the total available capacity sage has
@@ -262,9 +268,10 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 		h.podFilter,
 		h.resourceNames,
 		continueEvictionCond,
+		nil,
 		h.usageClient,
 		nil,
 	)
 
-	return nil
+	return nodeClassificationStatus("", classes)
 }
@@ -102,16 +102,10 @@ func NewLowNodeUtilization(
 
 	// this plugins supports different ways of collecting usage data. each
 	// different way provides its own "usageClient". here we make sure we
-	// have the correct one or an error is triggered. XXX MetricsServer is
-	// deprecated, removed once dropped.
-	var usageClient usageClient = newRequestedUsageClient(
-		extendedResourceNames, handle.GetPodsAssignedToNodeFunc(),
-	)
-	if metrics != nil {
-		usageClient, err = usageClientForMetrics(args, handle, extendedResourceNames)
-		if err != nil {
-			return nil, err
-		}
+	// have the correct one or an error is triggered.
+	usageClient, err := newUsageClient(args, handle, extendedResourceNames)
+	if err != nil {
+		return nil, err
 	}
 
 	return &LowNodeUtilization{
@@ -135,6 +129,17 @@ func (l *LowNodeUtilization) Name() string {
 // utilized nodes to under utilized nodes. The goal here is to evenly
 // distribute pods across nodes.
 func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	// the handle may be updated between the descheduling cycles, e.g. with
+	// the fake informers of a dry run or a renewed prometheus client, so
+	// the usage client is built from its current state every time.
+	usageClient, err := newUsageClient(l.args, l.handle, l.extendedResourceNames)
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: fmt.Errorf("error getting node usage: %v", err),
+		}
+	}
+	l.usageClient = usageClient
+
 	if err := l.usageClient.sync(ctx, nodes); err != nil {
 		return &frameworktypes.Status{
 			Err: fmt.Errorf("error getting node usage: %v", err),
@@ -243,6 +248,7 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 	}
 
 	lowNodes, highNodes := nodeInfos[0], nodeInfos[1]
+	classes := map[string][]NodeInfo{"underutilized": lowNodes, "overutilized": highNodes}
 
 	// log messages for nodes with low and high utilization
 	klog.V(1).InfoS("Criteria for a node under utilization", l.underCriteria...)
@@ -254,7 +260,7 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 		klog.V(1).InfoS(
 			"No node is underutilized, nothing to do here, you might tune your thresholds further",
 		)
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	if len(lowNodes) <= l.args.NumberOfNodes {
@@ -263,17 +269,17 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 			"underutilizedNodes", len(lowNodes),
 			"numberOfNodes", l.args.NumberOfNodes,
 		)
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	if len(lowNodes) == len(nodes) {
 		klog.V(1).InfoS("All nodes are underutilized, nothing to do here")
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	if len(highNodes) == 0 {
 		klog.V(1).InfoS("All nodes are under target utilization, nothing to do here")
-		return nil
+		return nodeClassificationStatus(frameworktypes.Skipped, classes)
 	}
 
 	// this is a stop condition for the eviction process. we stop as soon
@@ -309,11 +315,15 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 		l.podFilter,
 		l.extendedResourceNames,
 		continueEvictionCond,
+		// nodes further above the target threshold benefit the most
+		func(nodeInfo NodeInfo) float64 {
+			return usageToThresholdRatio(nodeInfo.NodeUsage, nodeInfo.available)
+		},
 		l.usageClient,
 		nodeLimit,
 	)
 
-	return nil
+	return nodeClassificationStatus("", classes)
 }
 
 // validatePrometheusMetricsUtilization validates the Prometheus metrics
@@ -346,6 +356,17 @@ func validatePrometheusMetricsUtilization(args *LowNodeUtilizationArgs) error {
 	return nil
 }
 
+// newUsageClient returns the usage client reading the pods and the usage
+// data from the handle. XXX MetricsServer is deprecated, removed once dropped.
+func newUsageClient(
+	args *LowNodeUtilizationArgs, handle frameworktypes.Handle, resources []v1.ResourceName,
+) (usageClient, error) {
+	if args.MetricsUtilization == nil {
+		return newRequestedUsageClient(resources, handle.GetPodsAssignedToNodeFunc()), nil
+	}
+	return usageClientForMetrics(args, handle, resources)
+}
+
 // usageClientForMetrics returns the correct usage client based on the
 // metrics source. XXX MetricsServer is deprecated, removed once dropped.
 func usageClientForMetrics(
@@ -19,6 +19,7 @@ package nodeutilization
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -36,6 +37,7 @@ import (
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
@@ -1830,9 +1832,12 @@ func TestLowNodeUtilizationWithPrometheusMetrics(t *testing.T) {
 				}
 
 				status := plugin.(frameworktypes.BalancePlugin).Balance(ctx, tc.nodes)
-				if status != nil {
+				if status != nil && status.Err != nil {
 					t.Fatalf("Balance.err: %v", status.Err)
 				}
+				if status == nil || status.NodeClassification == nil {
+					t.Errorf("Expected the node classification to be reported")
+				}
 
 				podsEvicted := podEvictor.TotalEvicted()
 				if expectedPodsEvicted != podsEvicted {
@@ -1846,3 +1851,102 @@ func TestLowNodeUtilizationWithPrometheusMetrics(t *testing.T) {
 		t.Run(tc.name, testFnc(false, tc.expectedPodsEvicted))
 	}
 }
+
+// closablePromClient fails the queries once closed, the same way as the
+// client of a rotated token once its transport gets closed.
+type closablePromClient struct {
+	*fakePromClient
+	closed bool
+}
+
+func (client *closablePromClient) Do(ctx context.Context, request *http.Request) (*http.Response, []byte, error) {
+	if client.closed {
+		return nil, nil, fmt.Errorf("prometheus client closed")
+	}
+	return client.fakePromClient.Do(ctx, request)
+}
+
+func TestLowNodeUtilizationHandleUpdatedBetweenCycles(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	n1NodeName := "n1"
+	n2NodeName := "n2"
+	n3NodeName := "n3"
+	nodes := []*v1.Node{
+		test.BuildTestNode(n1NodeName, 4000, 3000, 10, nil),
+		test.BuildTestNode(n2NodeName, 4000, 3000, 10, nil),
+		test.BuildTestNode(n3NodeName, 4000, 3000, 10, nil),
+	}
+	samples := model.Vector{
+		sample("instance:node_cpu:rate:sum", n1NodeName, 0.9),
+		sample("instance:node_cpu:rate:sum", n2NodeName, 0.4),
+		sample("instance:node_cpu:rate:sum", n3NodeName, 0.1),
+	}
+
+	objs := []runtime.Object{}
+	for _, node := range nodes {
+		objs = append(objs, node)
+	}
+	for i := 0; i < 4; i++ {
+		objs = append(objs, test.BuildTestPod(fmt.Sprintf("p%v", i), 400, 0, n1NodeName, test.SetRSOwnerRef))
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, evictions.NewOptions().WithDryRun(true), defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+	getPodsAssignedToNode := handle.GetPodsAssignedToNodeFuncImpl
+
+	// every dry run cycle lists the pods through the fake informers of the
+	// cycle and every token rotation replaces the prometheus client.
+	type cycle struct {
+		informerStopped bool
+		promClient      *closablePromClient
+	}
+	startCycle := func() *cycle {
+		c := &cycle{promClient: &closablePromClient{fakePromClient: &fakePromClient{result: samples, dataType: model.ValVector}}}
+		handle.GetPodsAssignedToNodeFuncImpl = func(nodeName string, filter podutil.FilterFunc) ([]*v1.Pod, error) {
+			if c.informerStopped {
+				return nil, fmt.Errorf("informer of a previous cycle stopped")
+			}
+			return getPodsAssignedToNode(nodeName, filter)
+		}
+		handle.PrometheusClientImpl = c.promClient
+		return c
+	}
+	endCycle := func(c *cycle) {
+		c.informerStopped = true
+		c.promClient.closed = true
+	}
+
+	first := startCycle()
+	plugin, err := NewLowNodeUtilization(&LowNodeUtilizationArgs{
+		Thresholds:       api.ResourceThresholds{MetricResource: 30},
+		TargetThresholds: api.ResourceThresholds{MetricResource: 50},
+		MetricsUtilization: &MetricsUtilization{
+			Source:     api.PrometheusMetrics,
+			Prometheus: &Prometheus{Query: "instance:node_cpu:rate:sum"},
+		},
+	}, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		c := first
+		if i > 0 {
+			c = startCycle()
+		}
+		podEvictor.ResetCounters()
+		status := plugin.(frameworktypes.BalancePlugin).Balance(ctx, nodes)
+		if status != nil && status.Err != nil {
+			t.Fatalf("Unexpected error in cycle %v: %v", i, status.Err)
+		}
+		if podEvictor.TotalEvicted() == 0 {
+			t.Errorf("Expected pods to be evicted in cycle %v", i)
+		}
+		endCycle(c)
+	}
+}
@@ -100,10 +100,27 @@ type NodeInfo struct {
 	available api.ReferencedResourceList
 }
 
+// nodeClassificationStatus returns a status carrying the names of the nodes
+// in each of the classes. An empty code is derived by the framework.
+func nodeClassificationStatus(code frameworktypes.StatusCode, classes map[string][]NodeInfo) *frameworktypes.Status {
+	classification := map[string][]string{}
+	for class, nodeInfos := range classes {
+		names := []string{}
+		for _, nodeInfo := range nodeInfos {
+			names = append(names, nodeInfo.node.Name)
+		}
+		classification[class] = names
+	}
+	return &frameworktypes.Status{Code: code, NodeClassification: classification}
+}
+
 // continueEvictionCont is a function that determines if we should keep
 // evicting pods or not.
 type continueEvictionCond func(NodeInfo, api.ReferencedResourceList) bool
 
+// scoreNodeFunc estimates the benefit of evicting pods from a source node.
+type scoreNodeFunc func(NodeInfo) float64
+
 // getNodeUsageSnapshot separates the snapshot into easily accesible data
 // chunks so the node usage can be processed separately. returns a map of
 // nodes, a map of their usage and a map of their pods. maps are indexed
@@ -173,6 +190,7 @@ func evictPodsFromSourceNodes(
 	podFilter func(pod *v1.Pod) bool,
 	resourceNames []v1.ResourceName,
 	continueEviction continueEvictionCond,
+	scoreNode scoreNodeFunc,
 	usageClient usageClient,
 	maxNoOfPodsToEvictPerNode *uint,
 ) {
@@ -219,8 +237,18 @@ func evictPodsFromSourceNodes(
 
 		// sort the evictable Pods based on priority. This also sorts
 		// them based on QoS. If there are multiple pods with same
-		// priority, they are sorted based on QoS tiers.
+		// priority, they are sorted based on QoS tiers. The pre-sort
+		// plugins order the pods which are equal in both.
+		podEvictor.PreSort(removablePods)
 		podutil.SortPodsBasedOnPriorityLowToHigh(removablePods)
+		// any sort plugins configured in the profile take precedence
+		// over the default ordering
+		podEvictor.Sort(removablePods)
+
+		nodeEvictOptions := evictOptions
+		if scoreNode != nil {
+			nodeEvictOptions.Score = scoreNode(node)
+		}
 
 		if err := evictPods(
 			ctx,
@@ -230,16 +258,12 @@ func evictPodsFromSourceNodes(
 			available,
 			destinationTaints,
 			podEvictor,
-			evictOptions,
+			nodeEvictOptions,
 			continueEviction,
 			usageClient,
 			maxNoOfPodsToEvictPerNode,
-		); err != nil {
-			switch err.(type) {
-			case *evictions.EvictionTotalLimitError:
-				return
-			default:
-			}
+		); err != nil && evictions.IsEvictionStopError(err) {
+			return
 		}
 	}
 }
@@ -321,11 +345,14 @@ func evictPods(
 		}
 
 		if err := podEvictor.Evict(ctx, pod, evictOptions); err != nil {
+			if evictions.IsEvictionStopError(err) {
+				return err
+			}
 			switch err.(type) {
-			case *evictions.EvictionNodeLimitError, *evictions.EvictionTotalLimitError:
+			case *evictions.EvictionNodeLimitError:
 				return err
 			default:
-				klog.Errorf("eviction failed: %v", err)
+				evictions.LogEvictionError(pod, err)
 				continue
 			}
 		}
@@ -416,6 +443,20 @@ func isNodeAboveTargetUtilization(usage NodeUsage, threshold api.ReferencedResou
 	return false
 }
 
+// usageToThresholdRatio returns the highest ratio of the node usage to the
+// threshold among the resources the threshold is set for.
+func usageToThresholdRatio(usage NodeUsage, threshold api.ReferencedResourceList) float64 {
+	var ratio float64
+	for name, nodeValue := range usage.usage {
+		thresholdValue, ok := threshold[name]
+		if !ok || thresholdValue == nil || nodeValue == nil || thresholdValue.IsZero() {
+			continue
+		}
+		ratio = max(ratio, nodeValue.AsApproximateFloat64()/thresholdValue.AsApproximateFloat64())
+	}
+	return ratio
+}
+
 // isNodeAboveThreshold checks if a node is over a threshold
 // At least one resource has to be above the threshold
 func isNodeAboveThreshold(usage, threshold api.ResourceThresholds) bool {
@@ -577,3 +577,23 @@ func TestNormalizeAndClassify(t *testing.T) {
 		})
 	}
 }
+
+func TestUsageToThresholdRatio(t *testing.T) {
+	usage := NodeUsage{
+		usage: api.ReferencedResourceList{
+			v1.ResourceCPU:    resource.NewMilliQuantity(1500, resource.DecimalSI),
+			v1.ResourceMemory: resource.NewQuantity(1000, resource.BinarySI),
+			v1.ResourcePods:   resource.NewQuantity(5, resource.DecimalSI),
+		},
+	}
+	threshold := api.ReferencedResourceList{
+		v1.ResourceCPU:    resource.NewMilliQuantity(1000, resource.DecimalSI),
+		v1.ResourceMemory: resource.NewQuantity(2000, resource.BinarySI),
+		v1.ResourcePods:   resource.NewQuantity(0, resource.DecimalSI),
+	}
+
+	// the pods threshold is zero and ignored, the cpu is the most used resource
+	if ratio := usageToThresholdRatio(usage, threshold); ratio != 1.5 {
+		t.Errorf("Expected the ratio to be 1.5, got %v", ratio)
+	}
+}
@@ -146,22 +146,34 @@ func (d *PodLifeTime) Deschedule(ctx context.Context, nodes []*v1.Node) *framewo
 	}
 
 	// Should sort Pods so that the oldest can be evicted first
-	// in the event that PDB or settings such maxNoOfPodsToEvictPer* prevent too much eviction
+	// in the event that PDB or settings such maxNoOfPodsToEvictPer* prevent too much eviction.
+	// The pre-sort plugins order the pods of the same age.
+	d.handle.Evictor().PreSort(podsToEvict)
 	podutil.SortPodsBasedOnAge(podsToEvict)
+	d.handle.Evictor().Sort(podsToEvict)
+
+	// the further a pod outlived the max lifetime the more it benefits from
+	// the eviction
+	maxLifeTimeSeconds := max(float64(*d.args.MaxPodLifeTimeSeconds), 1)
 
 loop:
 	for _, pod := range podsToEvict {
-		err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+		podAgeSeconds := metav1.Now().Sub(pod.GetCreationTimestamp().Local()).Seconds()
+		err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{
+			StrategyName: PluginName,
+			Score:        podAgeSeconds / maxLifeTimeSeconds,
+		})
 		if err == nil {
 			continue
 		}
+		if evictions.IsEvictionStopError(err) {
+			return nil
+		}
 		switch err.(type) {
 		case *evictions.EvictionNodeLimitError:
 			continue loop
-		case *evictions.EvictionTotalLimitError:
-			return nil
 		default:
-			klog.Errorf("eviction failed: %v", err)
+			evictions.LogEvictionError(pod, err)
 		}
 	}
 
@@ -18,17 +18,22 @@ package podlifetime
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
 	utilptr "k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsorting"
 	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/test"
@@ -656,3 +661,66 @@ func TestPodLifeTime(t *testing.T) {
 		})
 	}
 }
+
+func TestPodLifeTimePreSort(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	creationTime := metav1.NewTime(time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC))
+	buildPod := func(name string, priority int32) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+			pod.ObjectMeta.CreationTimestamp = creationTime
+			pod.Spec.Priority = utilptr.To(priority)
+		})
+	}
+	// the older pod is evicted first regardless of its priority
+	older := buildPod("older", 100)
+	older.ObjectMeta.CreationTimestamp = metav1.NewTime(creationTime.Add(-time.Hour))
+	pods := []*v1.Pod{buildPod("high", 100), older, buildPod("low", 10)}
+
+	var objs []runtime.Object
+	objs = append(objs, node1)
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	var evictedPods []string
+	fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			evictedPods = append(evictedPods, action.(core.CreateAction).GetObject().(*policy.Eviction).Name)
+		}
+		return false, nil, nil
+	})
+
+	handle, _, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		fakeClient,
+		evictions.NewOptions().WithMaxPodsToEvictTotal(utilptr.To[uint](2)),
+		defaultevictor.DefaultEvictorArgs{},
+		// the pods are listed by name, the pod of high priority first
+		func(pods []*v1.Pod) {
+			sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+		},
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+	prioritySort, err := podsorting.NewPrioritySort(&podsorting.PrioritySortArgs{}, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the pre-sort plugin: %v", err)
+	}
+	handle.PreSortPluginsImpl = []frameworktypes.PreSortPlugin{prioritySort.(frameworktypes.PreSortPlugin)}
+
+	plugin, err := New(&PodLifeTimeArgs{MaxPodLifeTimeSeconds: utilptr.To[uint](600)}, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+	plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{node1})
+
+	// the pods of the same age are evicted in the order given by the pre-sort plugin
+	if diff := cmp.Diff([]string{"older", "low"}, evictedPods); diff != "" {
+		t.Errorf("Unexpected evicted pods (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsorting
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_PrioritySortArgs
+func SetDefaults_PrioritySortArgs(obj runtime.Object) {}
+
+// SetDefaults_QoSClassSortArgs
+func SetDefaults_QoSClassSortArgs(obj runtime.Object) {}
+
+// SetDefaults_AgeSortArgs
+func SetDefaults_AgeSortArgs(obj runtime.Object) {
+	args := obj.(*AgeSortArgs)
+	if args.Order == "" {
+		args.Order = OldestFirst
+	}
+}
+
+// SetDefaults_RestartCountSortArgs
+func SetDefaults_RestartCountSortArgs(obj runtime.Object) {
+	args := obj.(*RestartCountSortArgs)
+	if !args.IncludingInitContainers {
+		args.IncludingInitContainers = false
+	}
+}
+
+// SetDefaults_DeletionCostSortArgs
+func SetDefaults_DeletionCostSortArgs(obj runtime.Object) {}
+
+// SetDefaults_StartupTimeSortArgs
+func SetDefaults_StartupTimeSortArgs(obj runtime.Object) {}
@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsorting
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const (
+	PrioritySortPluginName     = "PrioritySort"
+	QoSClassSortPluginName     = "QoSClassSort"
+	AgeSortPluginName          = "AgeSort"
+	RestartCountSortPluginName = "RestartCountSort"
+	DeletionCostSortPluginName = "DeletionCostSort"
+	StartupTimeSortPluginName  = "StartupTimeSort"
+)
+
+var (
+	_ frameworktypes.PreSortPlugin = &PrioritySort{}
+	_ frameworktypes.SortPlugin    = &PrioritySort{}
+	_ frameworktypes.PreSortPlugin = &QoSClassSort{}
+	_ frameworktypes.SortPlugin    = &QoSClassSort{}
+	_ frameworktypes.PreSortPlugin = &AgeSort{}
+	_ frameworktypes.SortPlugin    = &AgeSort{}
+	_ frameworktypes.PreSortPlugin = &RestartCountSort{}
+	_ frameworktypes.SortPlugin    = &RestartCountSort{}
+	_ frameworktypes.PreSortPlugin = &DeletionCostSort{}
+	_ frameworktypes.SortPlugin    = &DeletionCostSort{}
+	_ frameworktypes.PreSortPlugin = &StartupTimeSort{}
+	_ frameworktypes.SortPlugin    = &StartupTimeSort{}
+)
+
+// PrioritySort orders pods by their priority from low to high.
+// Pods with no priority set are ordered first.
+type PrioritySort struct {
+	handle frameworktypes.Handle
+	args   *PrioritySortArgs
+}
+
+// NewPrioritySort builds plugin from its arguments while passing a handle
+func NewPrioritySort(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	prioritySortArgs, ok := args.(*PrioritySortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type PrioritySortArgs, got %T", args)
+	}
+	return &PrioritySort{handle: handle, args: prioritySortArgs}, nil
+}
+
+// Name retrieves the plugin name
+func (s *PrioritySort) Name() string {
+	return PrioritySortPluginName
+}
+
+// PreLess reports whether pod1 is to be processed before pod2
+func (s *PrioritySort) PreLess(pod1, pod2 *v1.Pod) bool {
+	return s.Less(pod1, pod2)
+}
+
+// Less reports whether pod1 is to be evicted before pod2
+func (s *PrioritySort) Less(pod1, pod2 *v1.Pod) bool {
+	if pod1.Spec.Priority == nil {
+		return pod2.Spec.Priority != nil
+	}
+	if pod2.Spec.Priority == nil {
+		return false
+	}
+	return *pod1.Spec.Priority < *pod2.Spec.Priority
+}
+
+// QoSClassSort orders pods by their QoS class in the following order:
+// BestEffort, Burstable, Guaranteed
+type QoSClassSort struct {
+	handle frameworktypes.Handle
+	args   *QoSClassSortArgs
+}
+
+// NewQoSClassSort builds plugin from its arguments while passing a handle
+func NewQoSClassSort(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	qosClassSortArgs, ok := args.(*QoSClassSortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type QoSClassSortArgs, got %T", args)
+	}
+	return &QoSClassSort{handle: handle, args: qosClassSortArgs}, nil
+}
+
+// Name retrieves the plugin name
+func (s *QoSClassSort) Name() string {
+	return QoSClassSortPluginName
+}
+
+// PreLess reports whether pod1 is to be processed before pod2
+func (s *QoSClassSort) PreLess(pod1, pod2 *v1.Pod) bool {
+	return s.Less(pod1, pod2)
+}
+
+// Less reports whether pod1 is to be evicted before pod2
+func (s *QoSClassSort) Less(pod1, pod2 *v1.Pod) bool {
+	return qosClassRank(pod1) < qosClassRank(pod2)
+}
+
+func qosClassRank(pod *v1.Pod) int {
+	switch utils.GetPodQOS(pod) {
+	case v1.PodQOSBestEffort:
+		return 0
+	case v1.PodQOSBurstable:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// AgeSort orders pods by their creation timestamp.
+// The oldest pods are ordered first unless configured otherwise.
+type AgeSort struct {
+	handle frameworktypes.Handle
+	args   *AgeSortArgs
+}
+
+// NewAgeSort builds plugin from its arguments while passing a handle
+func NewAgeSort(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	ageSortArgs, ok := args.(*AgeSortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type AgeSortArgs, got %T", args)
+	}
+	return &AgeSort{handle: handle, args: ageSortArgs}, nil
+}
+
+// Name retrieves the plugin name
+func (s *AgeSort) Name() string {
+	return AgeSortPluginName
+}
+
+// PreLess reports whether pod1 is to be processed before pod2
+func (s *AgeSort) PreLess(pod1, pod2 *v1.Pod) bool {
+	return s.Less(pod1, pod2)
+}
+
+// Less reports whether pod1 is to be evicted before pod2
+func (s *AgeSort) Less(pod1, pod2 *v1.Pod) bool {
+	if s.args.Order == NewestFirst {
+		return pod2.CreationTimestamp.Before(&pod1.CreationTimestamp)
+	}
+	return pod1.CreationTimestamp.Before(&pod2.CreationTimestamp)
+}
+
+// RestartCountSort orders pods by their number of container restarts
+// from high to low.
+type RestartCountSort struct {
+	handle frameworktypes.Handle
+	args   *RestartCountSortArgs
+}
+
+// NewRestartCountSort builds plugin from its arguments while passing a handle
+func NewRestartCountSort(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	restartCountSortArgs, ok := args.(*RestartCountSortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type RestartCountSortArgs, got %T", args)
+	}
+	return &RestartCountSort{handle: handle, args: restartCountSortArgs}, nil
+}
+
+// Name retrieves the plugin name
+func (s *RestartCountSort) Name() string {
+	return RestartCountSortPluginName
+}
+
+// PreLess reports whether pod1 is to be processed before pod2
+func (s *RestartCountSort) PreLess(pod1, pod2 *v1.Pod) bool {
+	return s.Less(pod1, pod2)
+}
+
+// Less reports whether pod1 is to be evicted before pod2
+func (s *RestartCountSort) Less(pod1, pod2 *v1.Pod) bool {
+	return s.restarts(pod1) > s.restarts(pod2)
+}
+
+func (s *RestartCountSort) restarts(pod *v1.Pod) int32 {
+	var restarts int32
+	for _, cs := range pod.Status.ContainerStatuses {
+		restarts += cs.RestartCount
+	}
+	if s.args.IncludingInitContainers {
+		for _, cs := range pod.Status.InitContainerStatuses {
+			restarts += cs.RestartCount
+		}
+	}
+	return restarts
+}
+
+// DeletionCostSort orders pods by the value of their
+// controller.kubernetes.io/pod-deletion-cost annotation from low to high.
+// Pods without the annotation (or with an invalid value) have a cost of 0,
+// same as the ReplicaSet controller assumes when scaling down.
+type DeletionCostSort struct {
+	handle frameworktypes.Handle
+	args   *DeletionCostSortArgs
+}
+
+// NewDeletionCostSort builds plugin from its arguments while passing a handle
+func NewDeletionCostSort(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	deletionCostSortArgs, ok := args.(*DeletionCostSortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type DeletionCostSortArgs, got %T", args)
+	}
+	return &DeletionCostSort{handle: handle, args: deletionCostSortArgs}, nil
+}
+
+// Name retrieves the plugin name
+func (s *DeletionCostSort) Name() string {
+	return DeletionCostSortPluginName
+}
+
+// PreLess reports whether pod1 is to be processed before pod2
+func (s *DeletionCostSort) PreLess(pod1, pod2 *v1.Pod) bool {
+	return s.Less(pod1, pod2)
+}
+
+// Less reports whether pod1 is to be evicted before pod2
+func (s *DeletionCostSort) Less(pod1, pod2 *v1.Pod) bool {
+	return podDeletionCost(pod1) < podDeletionCost(pod2)
+}
+
+func podDeletionCost(pod *v1.Pod) int32 {
+	value, exists := pod.Annotations[v1.PodDeletionCost]
+	if !exists {
+		return 0
+	}
+	cost, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(cost)
+}
+
+// StartupTimeSort orders pods by the time it took them to become ready
+// from short to long. The startup time is measured as the time between
+// the pod start and the last transition of its Ready condition to True.
+// Pods that are not ready (or do not report a start time) are ordered last.
+type StartupTimeSort struct {
+	handle frameworktypes.Handle
+	args   *StartupTimeSortArgs
+}
+
+// NewStartupTimeSort builds plugin from its arguments while passing a handle
+func NewStartupTimeSort(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	startupTimeSortArgs, ok := args.(*StartupTimeSortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type StartupTimeSortArgs, got %T", args)
+	}
+	return &StartupTimeSort{handle: handle, args: startupTimeSortArgs}, nil
+}
+
+// Name retrieves the plugin name
+func (s *StartupTimeSort) Name() string {
+	return StartupTimeSortPluginName
+}
+
+// PreLess reports whether pod1 is to be processed before pod2
+func (s *StartupTimeSort) PreLess(pod1, pod2 *v1.Pod) bool {
+	return s.Less(pod1, pod2)
+}
+
+// Less reports whether pod1 is to be evicted before pod2
+func (s *StartupTimeSort) Less(pod1, pod2 *v1.Pod) bool {
+	startup1, measured1 := podStartupTime(pod1)
+	startup2, measured2 := podStartupTime(pod2)
+	if !measured1 || !measured2 {
+		return measured1 && !measured2
+	}
+	return startup1 < startup2
+}
+
+func podStartupTime(pod *v1.Pod) (time.Duration, bool) {
+	if pod.Status.StartTime == nil {
+		return 0, false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue {
+			if condition.LastTransitionTime.Before(pod.Status.StartTime) {
+				return 0, false
+			}
+			return condition.LastTransitionTime.Sub(pod.Status.StartTime.Time), true
+		}
+	}
+	return 0, false
+}
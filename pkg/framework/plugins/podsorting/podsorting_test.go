@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsorting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func buildPod(name string, apply func(pod *v1.Pod)) *v1.Pod {
+	return test.BuildTestPod(name, 100, 100, "n1", apply)
+}
+
+func podNames(pods []*v1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestSortPlugins(t *testing.T) {
+	now := time.Now()
+	lowPriority := int32(100)
+	highPriority := int32(1000)
+
+	tests := []struct {
+		description string
+		builder     func(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error)
+		args        runtime.Object
+		pods        []*v1.Pod
+		expected    []string
+	}{
+		{
+			description: "priority from low to high, no priority first",
+			builder:     NewPrioritySort,
+			args:        &PrioritySortArgs{},
+			pods: []*v1.Pod{
+				buildPod("high", func(pod *v1.Pod) { pod.Spec.Priority = &highPriority }),
+				buildPod("low", func(pod *v1.Pod) { pod.Spec.Priority = &lowPriority }),
+				buildPod("none", nil),
+			},
+			expected: []string{"none", "low", "high"},
+		},
+		{
+			description: "qos class from best effort to guaranteed",
+			builder:     NewQoSClassSort,
+			args:        &QoSClassSortArgs{},
+			pods: []*v1.Pod{
+				buildPod("guaranteed", test.MakeGuaranteedPod),
+				buildPod("burstable", nil),
+				buildPod("besteffort", test.MakeBestEffortPod),
+			},
+			expected: []string{"besteffort", "burstable", "guaranteed"},
+		},
+		{
+			description: "oldest first",
+			builder:     NewAgeSort,
+			args:        &AgeSortArgs{Order: OldestFirst},
+			pods: []*v1.Pod{
+				buildPod("new", func(pod *v1.Pod) { pod.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute)) }),
+				buildPod("old", func(pod *v1.Pod) { pod.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour)) }),
+			},
+			expected: []string{"old", "new"},
+		},
+		{
+			description: "newest first",
+			builder:     NewAgeSort,
+			args:        &AgeSortArgs{Order: NewestFirst},
+			pods: []*v1.Pod{
+				buildPod("old", func(pod *v1.Pod) { pod.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour)) }),
+				buildPod("new", func(pod *v1.Pod) { pod.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute)) }),
+			},
+			expected: []string{"new", "old"},
+		},
+		{
+			description: "restart count from high to low",
+			builder:     NewRestartCountSort,
+			args:        &RestartCountSortArgs{},
+			pods: []*v1.Pod{
+				buildPod("one", func(pod *v1.Pod) {
+					pod.Status.ContainerStatuses = []v1.ContainerStatus{{RestartCount: 1}}
+					pod.Status.InitContainerStatuses = []v1.ContainerStatus{{RestartCount: 10}}
+				}),
+				buildPod("five", func(pod *v1.Pod) {
+					pod.Status.ContainerStatuses = []v1.ContainerStatus{{RestartCount: 2}, {RestartCount: 3}}
+				}),
+			},
+			expected: []string{"five", "one"},
+		},
+		{
+			description: "restart count including init containers",
+			builder:     NewRestartCountSort,
+			args:        &RestartCountSortArgs{IncludingInitContainers: true},
+			pods: []*v1.Pod{
+				buildPod("five", func(pod *v1.Pod) {
+					pod.Status.ContainerStatuses = []v1.ContainerStatus{{RestartCount: 2}, {RestartCount: 3}}
+				}),
+				buildPod("eleven", func(pod *v1.Pod) {
+					pod.Status.ContainerStatuses = []v1.ContainerStatus{{RestartCount: 1}}
+					pod.Status.InitContainerStatuses = []v1.ContainerStatus{{RestartCount: 10}}
+				}),
+			},
+			expected: []string{"eleven", "five"},
+		},
+		{
+			description: "deletion cost from low to high, missing or invalid cost is 0",
+			builder:     NewDeletionCostSort,
+			args:        &DeletionCostSortArgs{},
+			pods: []*v1.Pod{
+				buildPod("high", func(pod *v1.Pod) { pod.Annotations = map[string]string{v1.PodDeletionCost: "100"} }),
+				buildPod("missing", nil),
+				buildPod("negative", func(pod *v1.Pod) { pod.Annotations = map[string]string{v1.PodDeletionCost: "-100"} }),
+				buildPod("invalid", func(pod *v1.Pod) { pod.Annotations = map[string]string{v1.PodDeletionCost: "abc"} }),
+			},
+			expected: []string{"negative", "missing", "invalid", "high"},
+		},
+		{
+			description: "startup time from short to long, not ready last",
+			builder:     NewStartupTimeSort,
+			args:        &StartupTimeSortArgs{},
+			pods: []*v1.Pod{
+				buildPod("notready", func(pod *v1.Pod) {
+					pod.Status.StartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+				}),
+				buildPod("slow", func(pod *v1.Pod) {
+					pod.Status.StartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+					pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-30 * time.Minute))}}
+				}),
+				buildPod("fast", func(pod *v1.Pod) {
+					pod.Status.StartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+					pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-59 * time.Minute))}}
+				}),
+			},
+			expected: []string{"fast", "slow", "notready"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			plugin, err := tc.builder(tc.args, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			pods := append([]*v1.Pod{}, tc.pods...)
+			podutil.SortPodsBasedOnLessFuncs(pods, plugin.(frameworktypes.SortPlugin).Less)
+			if diff := cmp.Diff(tc.expected, podNames(pods)); diff != "" {
+				t.Errorf("Unexpected sort order (-want, +got):\n%s", diff)
+			}
+
+			pods = append([]*v1.Pod{}, tc.pods...)
+			podutil.SortPodsBasedOnLessFuncs(pods, plugin.(frameworktypes.PreSortPlugin).PreLess)
+			if diff := cmp.Diff(tc.expected, podNames(pods)); diff != "" {
+				t.Errorf("Unexpected pre-sort order (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortPluginsPrecedence(t *testing.T) {
+	lowPriority := int32(100)
+	highPriority := int32(1000)
+
+	pods := []*v1.Pod{
+		buildPod("high-guaranteed", func(pod *v1.Pod) { pod.Spec.Priority = &highPriority; test.MakeGuaranteedPod(pod) }),
+		buildPod("low-guaranteed", func(pod *v1.Pod) { pod.Spec.Priority = &lowPriority; test.MakeGuaranteedPod(pod) }),
+		buildPod("high-besteffort", func(pod *v1.Pod) { pod.Spec.Priority = &highPriority; test.MakeBestEffortPod(pod) }),
+		buildPod("low-burstable", func(pod *v1.Pod) { pod.Spec.Priority = &lowPriority }),
+	}
+
+	prioritySort, _ := NewPrioritySort(&PrioritySortArgs{}, nil)
+	qosClassSort, _ := NewQoSClassSort(&QoSClassSortArgs{}, nil)
+
+	podutil.SortPodsBasedOnLessFuncs(pods, prioritySort.(frameworktypes.SortPlugin).Less, qosClassSort.(frameworktypes.SortPlugin).Less)
+
+	expected := []string{"low-burstable", "low-guaranteed", "high-besteffort", "high-guaranteed"}
+	if diff := cmp.Diff(expected, podNames(pods)); diff != "" {
+		t.Errorf("Unexpected sort order (-want, +got):\n%s", diff)
+	}
+}
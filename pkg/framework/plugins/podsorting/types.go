@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsorting
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AgeOrder describes in which order pods are sorted by their age.
+type AgeOrder string
+
+const (
+	// OldestFirst sorts the oldest pods first
+	OldestFirst AgeOrder = "OldestFirst"
+	// NewestFirst sorts the most recently created pods first
+	NewestFirst AgeOrder = "NewestFirst"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrioritySortArgs holds arguments used to configure PrioritySort plugin.
+type PrioritySortArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QoSClassSortArgs holds arguments used to configure QoSClassSort plugin.
+type QoSClassSortArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AgeSortArgs holds arguments used to configure AgeSort plugin.
+type AgeSortArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Order AgeOrder `json:"order,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RestartCountSortArgs holds arguments used to configure RestartCountSort plugin.
+type RestartCountSortArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	IncludingInitContainers bool `json:"includingInitContainers,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeletionCostSortArgs holds arguments used to configure DeletionCostSort plugin.
+type DeletionCostSortArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// StartupTimeSortArgs holds arguments used to configure StartupTimeSort plugin.
+type StartupTimeSortArgs struct {
+	metav1.TypeMeta `json:",inline"`
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsorting
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func ValidatePrioritySortArgs(obj runtime.Object) error {
+	return nil
+}
+
+func ValidateQoSClassSortArgs(obj runtime.Object) error {
+	return nil
+}
+
+func ValidateAgeSortArgs(obj runtime.Object) error {
+	args := obj.(*AgeSortArgs)
+	switch args.Order {
+	case "", OldestFirst, NewestFirst:
+		return nil
+	default:
+		return fmt.Errorf("order must be one of %q or %q, got %q", OldestFirst, NewestFirst, args.Order)
+	}
+}
+
+func ValidateRestartCountSortArgs(obj runtime.Object) error {
+	return nil
+}
+
+func ValidateDeletionCostSortArgs(obj runtime.Object) error {
+	return nil
+}
+
+func ValidateStartupTimeSortArgs(obj runtime.Object) error {
+	return nil
+}
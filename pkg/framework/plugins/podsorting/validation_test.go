@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsorting
+
+import (
+	"testing"
+)
+
+func TestValidateAgeSortArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *AgeSortArgs
+		expectError bool
+	}{
+		{
+			description: "empty order, no errors",
+			args:        &AgeSortArgs{},
+			expectError: false,
+		},
+		{
+			description: "OldestFirst order, no errors",
+			args:        &AgeSortArgs{Order: OldestFirst},
+			expectError: false,
+		},
+		{
+			description: "NewestFirst order, no errors",
+			args:        &AgeSortArgs{Order: NewestFirst},
+			expectError: false,
+		},
+		{
+			description: "unknown order, expects errors",
+			args:        &AgeSortArgs{Order: "Random"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateAgeSortArgs(tc.args)
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Errorf("Unexpected validation result, expected error: %v, got: %v", tc.expectError, err)
+			}
+		})
+	}
+}
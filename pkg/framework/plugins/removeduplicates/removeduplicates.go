@@ -112,6 +112,9 @@ func (r *RemoveDuplicates) Balance(ctx context.Context, nodes []*v1.Node) *frame
 		}
 		nodeMap[node.Name] = node
 		nodeCount++
+		// The first pod seen for each owner is kept, the remaining ones are
+		// considered duplicates. Let the pre-sort plugins decide the order.
+		r.handle.Evictor().PreSort(pods)
 		// Each pod has a list of owners and a list of containers, and each container has 1 image spec.
 		// For each pod, we go through all the OwnerRef/Image mappings and represent them as a "key" string.
 		// All of those mappings together makes a list of "key" strings that essentially represent that pod's uniqueness.
@@ -209,18 +212,21 @@ func (r *RemoveDuplicates) Balance(ctx context.Context, nodes []*v1.Node) *frame
 			if len(pods)+1 > upperAvg {
 				// It's assumed all duplicated pods are in the same priority class
 				// TODO(jchaloup): check if the pod has a different node to lend to
-				for _, pod := range pods[upperAvg-1:] {
+				podsToEvict := pods[upperAvg-1:]
+				r.handle.Evictor().Sort(podsToEvict)
+				for _, pod := range podsToEvict {
 					err := r.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
 					if err == nil {
 						continue
 					}
+					if evictions.IsEvictionStopError(err) {
+						return nil
+					}
 					switch err.(type) {
 					case *evictions.EvictionNodeLimitError:
 						continue loop
-					case *evictions.EvictionTotalLimitError:
-						return nil
 					default:
-						klog.Errorf("eviction failed: %v", err)
+						evictions.LogEvictionError(pod, err)
 					}
 				}
 			}
@@ -29,6 +29,7 @@ import (
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 )
 
@@ -92,31 +93,37 @@ func (d *RemoveFailedPods) Name() string {
 
 // Deschedule extension point implementation for the plugin
 func (d *RemoveFailedPods) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
-	for _, node := range nodes {
+	err := d.handle.Parallelizer().UntilError(ctx, len(nodes), func(piece int) error {
+		node := nodes[piece]
 		klog.V(2).InfoS("Processing node", "node", klog.KObj(node))
 		pods, err := podutil.ListAllPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
 		if err != nil {
-			// no pods evicted as error encountered retrieving evictable Pods
-			return &frameworktypes.Status{
-				Err: fmt.Errorf("error listing pods on a node: %v", err),
-			}
+			return fmt.Errorf("error listing pods on a node: %v", err)
 		}
-		totalPods := len(pods)
-	loop:
-		for i := 0; i < totalPods; i++ {
-			err := d.handle.Evictor().Evict(ctx, pods[i], evictions.EvictOptions{StrategyName: PluginName})
+		d.handle.Evictor().PreSort(pods)
+		d.handle.Evictor().Sort(pods)
+		for _, pod := range pods {
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
 			if err == nil {
 				continue
 			}
+			if evictions.IsEvictionStopError(err) {
+				return parallelize.ErrStop
+			}
 			switch err.(type) {
 			case *evictions.EvictionNodeLimitError:
-				break loop
-			case *evictions.EvictionTotalLimitError:
 				return nil
 			default:
-				klog.Errorf("eviction failed: %v", err)
+				evictions.LogEvictionError(pod, err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		// no pods evicted as error encountered retrieving evictable Pods
+		return &frameworktypes.Status{
+			Err: err,
+		}
 	}
 	return nil
 }
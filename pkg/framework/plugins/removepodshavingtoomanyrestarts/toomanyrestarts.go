@@ -28,6 +28,7 @@ import (
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 )
 
@@ -113,40 +114,52 @@ func (d *RemovePodsHavingTooManyRestarts) Name() string {
 
 // Deschedule extension point implementation for the plugin
 func (d *RemovePodsHavingTooManyRestarts) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
-	for _, node := range nodes {
+	err := d.handle.Parallelizer().UntilError(ctx, len(nodes), func(piece int) error {
+		node := nodes[piece]
 		klog.V(2).InfoS("Processing node", "node", klog.KObj(node))
 		pods, err := podutil.ListAllPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
 		if err != nil {
-			// no pods evicted as error encountered retrieving evictable Pods
-			return &frameworktypes.Status{
-				Err: fmt.Errorf("error listing pods on a node: %v", err),
-			}
+			return fmt.Errorf("error listing pods on a node: %v", err)
 		}
 
 		podRestarts := make(map[*v1.Pod]int32)
 		for _, pod := range pods {
 			podRestarts[pod] = getPodTotalRestarts(pod, d.args.IncludingInitContainers)
 		}
-		// sort pods by restarts count
-		sort.Slice(pods, func(i, j int) bool {
+		// sort pods by restarts count, the pre-sort plugins order the pods with the same count
+		d.handle.Evictor().PreSort(pods)
+		sort.SliceStable(pods, func(i, j int) bool {
 			return podRestarts[pods[i]] > podRestarts[pods[j]]
 		})
-		totalPods := len(pods)
-	loop:
-		for i := 0; i < totalPods; i++ {
-			err := d.handle.Evictor().Evict(ctx, pods[i], evictions.EvictOptions{StrategyName: PluginName})
+		d.handle.Evictor().Sort(pods)
+		// the further a pod is past the restart threshold the more it
+		// benefits from the eviction
+		restartThreshold := float64(max(d.args.PodRestartThreshold, 1))
+		for _, pod := range pods {
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{
+				StrategyName: PluginName,
+				Score:        float64(podRestarts[pod]) / restartThreshold,
+			})
 			if err == nil {
 				continue
 			}
+			if evictions.IsEvictionStopError(err) {
+				return parallelize.ErrStop
+			}
 			switch err.(type) {
 			case *evictions.EvictionNodeLimitError:
-				break loop
-			case *evictions.EvictionTotalLimitError:
 				return nil
 			default:
-				klog.Errorf("eviction failed: %v", err)
+				evictions.LogEvictionError(pod, err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		// no pods evicted as error encountered retrieving evictable Pods
+		return &frameworktypes.Status{
+			Err: err,
+		}
 	}
 	return nil
 }
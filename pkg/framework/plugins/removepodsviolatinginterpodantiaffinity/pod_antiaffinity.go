@@ -84,6 +84,7 @@ func (d *RemovePodsViolatingInterPodAntiAffinity) Deschedule(ctx context.Context
 		}
 	}
 
+	d.handle.Evictor().PreSort(pods)
 	podsInANamespace := podutil.GroupByNamespace(pods)
 	podsOnANode := podutil.GroupByNodeName(pods)
 	nodeMap := utils.CreateNodeMap(nodes)
@@ -94,6 +95,7 @@ loop:
 		pods := podsOnANode[node.Name]
 		// sort the evict-able Pods based on priority, if there are multiple pods with same priority, they are sorted based on QoS tiers.
 		podutil.SortPodsBasedOnPriorityLowToHigh(pods)
+		d.handle.Evictor().Sort(pods)
 		totalPods := len(pods)
 		for i := 0; i < totalPods; i++ {
 			if utils.CheckPodsWithAntiAffinityExist(pods[i], podsInANamespace, nodeMap) {
@@ -109,13 +111,14 @@ loop:
 						totalPods--
 						continue
 					}
+					if evictions.IsEvictionStopError(err) {
+						return nil
+					}
 					switch err.(type) {
 					case *evictions.EvictionNodeLimitError:
 						continue loop
-					case *evictions.EvictionTotalLimitError:
-						return nil
 					default:
-						klog.Errorf("eviction failed: %v", err)
+						evictions.LogEvictionError(pods[i], err)
 					}
 				}
 			}
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/utils"
 )
@@ -117,7 +118,8 @@ func (d *RemovePodsViolatingNodeAffinity) Deschedule(ctx context.Context, nodes
 }
 
 func (d *RemovePodsViolatingNodeAffinity) processNodes(ctx context.Context, nodes []*v1.Node, filterFunc func(*v1.Pod, *v1.Node, []*v1.Node) bool) *frameworktypes.Status {
-	for _, node := range nodes {
+	err := d.handle.Parallelizer().UntilError(ctx, len(nodes), func(piece int) error {
+		node := nodes[piece]
 		klog.V(2).InfoS("Processing node", "node", klog.KObj(node))
 
 		// Potentially evictable pods
@@ -129,27 +131,33 @@ func (d *RemovePodsViolatingNodeAffinity) processNodes(ctx context.Context, node
 			}),
 		)
 		if err != nil {
-			return &frameworktypes.Status{
-				Err: fmt.Errorf("error listing pods on a node: %v", err),
-			}
+			return fmt.Errorf("error listing pods on a node: %v", err)
 		}
+		d.handle.Evictor().PreSort(pods)
+		d.handle.Evictor().Sort(pods)
 
-	loop:
 		for _, pod := range pods {
 			klog.V(1).InfoS("Evicting pod", "pod", klog.KObj(pod))
 			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
 			if err == nil {
 				continue
 			}
+			if evictions.IsEvictionStopError(err) {
+				return parallelize.ErrStop
+			}
 			switch err.(type) {
 			case *evictions.EvictionNodeLimitError:
-				break loop
-			case *evictions.EvictionTotalLimitError:
 				return nil
 			default:
-				klog.Errorf("eviction failed: %v", err)
+				evictions.LogEvictionError(pod, err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: err,
+		}
 	}
 	return nil
 }
@@ -27,6 +27,7 @@ import (
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/utils"
 )
@@ -104,37 +105,44 @@ func (d *RemovePodsViolatingNodeTaints) Name() string {
 
 // Deschedule extension point implementation for the plugin
 func (d *RemovePodsViolatingNodeTaints) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
-	for _, node := range nodes {
+	err := d.handle.Parallelizer().UntilError(ctx, len(nodes), func(piece int) error {
+		node := nodes[piece]
 		klog.V(1).InfoS("Processing node", "node", klog.KObj(node))
 		pods, err := podutil.ListPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
 		if err != nil {
-			// no pods evicted as error encountered retrieving evictable Pods
-			return &frameworktypes.Status{
-				Err: fmt.Errorf("error listing pods on a node: %v", err),
-			}
+			return fmt.Errorf("error listing pods on a node: %v", err)
 		}
-		totalPods := len(pods)
-	loop:
-		for i := 0; i < totalPods; i++ {
-			if !utils.TolerationsTolerateTaintsWithFilter(
-				pods[i].Spec.Tolerations,
+		d.handle.Evictor().PreSort(pods)
+		d.handle.Evictor().Sort(pods)
+		for _, pod := range pods {
+			if utils.TolerationsTolerateTaintsWithFilter(
+				pod.Spec.Tolerations,
 				node.Spec.Taints,
 				d.taintFilterFnc,
 			) {
-				klog.V(2).InfoS("Not all taints with NoSchedule effect are tolerated after update for pod on node", "pod", klog.KObj(pods[i]), "node", klog.KObj(node))
-				err := d.handle.Evictor().Evict(ctx, pods[i], evictions.EvictOptions{StrategyName: PluginName})
-				if err == nil {
-					continue
-				}
-				switch err.(type) {
-				case *evictions.EvictionNodeLimitError:
-					break loop
-				case *evictions.EvictionTotalLimitError:
-					return nil
-				default:
-					klog.Errorf("eviction failed: %v", err)
-				}
+				continue
+			}
+			klog.V(2).InfoS("Not all taints with NoSchedule effect are tolerated after update for pod on node", "pod", klog.KObj(pod), "node", klog.KObj(node))
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+			if err == nil {
+				continue
+			}
+			if evictions.IsEvictionStopError(err) {
+				return parallelize.ErrStop
 			}
+			switch err.(type) {
+			case *evictions.EvictionNodeLimitError:
+				return nil
+			default:
+				evictions.LogEvictionError(pod, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// no pods evicted as error encountered retrieving evictable Pods
+		return &frameworktypes.Status{
+			Err: err,
 		}
 	}
 
@@ -136,6 +136,8 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 			Err: fmt.Errorf("error listing all pods: %v", err),
 		}
 	}
+	// the pre-sort plugins order the pods the domains consider equal for eviction
+	d.handle.Evictor().PreSort(pods)
 
 	allowedConstraints := sets.New[v1.UnsatisfiableConstraintAction](d.args.Constraints...)
 
@@ -223,8 +225,14 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 		}
 	}
 
-	nodeLimitExceeded := map[string]bool{}
+	podsToEvict := make([]*v1.Pod, 0, len(podsForEviction))
 	for pod := range podsForEviction {
+		podsToEvict = append(podsToEvict, pod)
+	}
+	d.handle.Evictor().Sort(podsToEvict)
+
+	nodeLimitExceeded := map[string]bool{}
+	for _, pod := range podsToEvict {
 		if nodeLimitExceeded[pod.Spec.NodeName] {
 			continue
 		}
@@ -237,13 +245,14 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 			if err == nil {
 				continue
 			}
+			if evictions.IsEvictionStopError(err) {
+				return nil
+			}
 			switch err.(type) {
 			case *evictions.EvictionNodeLimitError:
 				nodeLimitExceeded[pod.Spec.NodeName] = true
-			case *evictions.EvictionTotalLimitError:
-				return nil
 			default:
-				klog.Errorf("eviction failed: %v", err)
+				evictions.LogEvictionError(pod, err)
 			}
 		}
 	}
@@ -413,7 +422,7 @@ func sortDomains(constraintTopologyPairs map[topologyPair][]*v1.Pod, isEvictable
 		// followed by the highest priority,
 		// followed by the lowest priority pods with affinity or nodeSelector,
 		// followed by the highest priority pods with affinity or nodeSelector
-		sort.Slice(list, func(i, j int) bool {
+		sort.SliceStable(list, func(i, j int) bool {
 			// any non-evictable pods should be considered last (ie, first in the list)
 			evictableI := isEvictable(list[i])
 			evictableJ := isEvictable(list[j])
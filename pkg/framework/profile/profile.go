@@ -16,6 +16,7 @@ package profile
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	promapi "github.com/prometheus/client_golang/api"
@@ -25,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
@@ -34,6 +36,8 @@ import (
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
+	"sigs.k8s.io/descheduler/pkg/framework/plan"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/tracing"
@@ -42,28 +46,249 @@ import (
 // evictorImpl implements the Evictor interface so plugins
 // can evict a pod without importing a specific pod evictor
 type evictorImpl struct {
-	profileName       string
-	podEvictor        *evictions.PodEvictor
-	filter            podutil.FilterFunc
-	preEvictionFilter podutil.FilterFunc
+	profileName              string
+	podEvictor               *evictions.PodEvictor
+	filterPlugins            []filterPlugin
+	preEvictionFilterPlugins []preEvictionFilterPlugin
+	postEvictionPlugins      []frameworktypes.PostEvictionPlugin
+	preSort                  []podutil.LessFunc
+	sort                     []podutil.LessFunc
+	// span of the currently running plugin, filter rejections are recorded as its events
+	span trace.Span
+	// recorder collects the pods evicted and skipped by the currently running plugin
+	recorder *statusRecorder
+	// planner collects the evictions instead of executing them when set
+	planner *plan.Planner
+	// filtered keeps the pods already counted as rejected in the current descheduling cycle
+	filtered *filteredPods
+}
+
+// filteredPods is the set of pods rejected by the evictor plugins in a descheduling cycle,
+// so that a pod checked repeatedly is counted only once. Plugins may filter from multiple goroutines.
+type filteredPods struct {
+	mu   sync.Mutex
+	keys sets.Set[string]
+}
+
+func newFilteredPods() *filteredPods {
+	return &filteredPods{keys: sets.New[string]()}
+}
+
+// add records the rejection of a pod and reports whether it was not recorded before
+func (f *filteredPods) add(extensionPoint frameworktypes.ExtensionPoint, pluginName string, pod *v1.Pod) bool {
+	key := fmt.Sprintf("%v/%v/%v", extensionPoint, pluginName, pod.UID)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.keys.Has(key) {
+		return false
+	}
+	f.keys.Insert(key)
+	return true
+}
+
+// statusRecorder collects the pods evicted and skipped through the evictor while a plugin runs.
+// Plugins may evict from multiple goroutines.
+type statusRecorder struct {
+	mu           sync.Mutex
+	evicted      []frameworktypes.PodResult
+	skipped      []frameworktypes.PodResult
+	limitReached bool
+	// status is the completed status of the plugin, planned evictions are added to it once executed
+	status *frameworktypes.Status
+}
+
+func (r *statusRecorder) recordEviction(pod *v1.Pod, opts evictions.EvictOptions, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		r.evicted = append(r.evicted, frameworktypes.NewPodResult(pod, opts.Reason))
+		return
+	}
+	r.skipped = append(r.skipped, frameworktypes.NewPodResult(pod, err.Error()))
+	if evictions.IsEvictionLimitError(err) {
+		r.limitReached = true
+	}
+}
+
+func (r *statusRecorder) recordSkip(pod *v1.Pod, reason string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.skipped = append(r.skipped, frameworktypes.NewPodResult(pod, reason))
+}
+
+// complete adds the recorded pods to the status returned by a plugin
+// and derives the status code unless the plugin set it
+func (r *statusRecorder) complete(status *frameworktypes.Status) *frameworktypes.Status {
+	if status == nil {
+		status = &frameworktypes.Status{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status.Evicted = append(status.Evicted, r.evicted...)
+	status.Skipped = append(status.Skipped, r.skipped...)
+	r.status = status
+	if status.Code != "" {
+		return status
+	}
+	switch {
+	case status.Err != nil:
+		status.Code = frameworktypes.Error
+	case r.limitReached:
+		status.Code = frameworktypes.LimitReached
+	case len(status.Skipped) > 0:
+		status.Code = frameworktypes.Partial
+	default:
+		status.Code = frameworktypes.Success
+	}
+	return status
+}
+
+// recordPlanned adds the outcome of an eviction planned by the plugin
+// to its completed status and raises the status code accordingly
+func (r *statusRecorder) recordPlanned(pod *v1.Pod, opts evictions.EvictOptions, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	code := frameworktypes.Success
+	switch {
+	case err == nil:
+		r.status.Evicted = append(r.status.Evicted, frameworktypes.NewPodResult(pod, opts.Reason))
+	case evictions.IsEvictionLimitError(err):
+		r.status.Skipped = append(r.status.Skipped, frameworktypes.NewPodResult(pod, err.Error()))
+		code = frameworktypes.LimitReached
+	default:
+		r.status.Skipped = append(r.status.Skipped, frameworktypes.NewPodResult(pod, err.Error()))
+		code = frameworktypes.Partial
+	}
+	if statusSeverity[code] > statusSeverity[r.status.Code] {
+		r.status.Code = code
+	}
 }
 
 var _ frameworktypes.Evictor = &evictorImpl{}
 
 // Filter checks if a pod can be evicted
 func (ei *evictorImpl) Filter(pod *v1.Pod) bool {
-	return ei.filter(pod)
+	for _, pl := range ei.filterPlugins {
+		verdict := pluginVerdict(pl, pl.Filter(pod))
+		if !verdict.Allowed() {
+			ei.recordRejection(frameworktypes.FilterExtensionPoint, pod, verdict)
+			return false
+		}
+	}
+	return true
 }
 
 // PreEvictionFilter checks if pod can be evicted right before eviction
 func (ei *evictorImpl) PreEvictionFilter(pod *v1.Pod) bool {
-	return ei.preEvictionFilter(pod)
+	for _, pl := range ei.preEvictionFilterPlugins {
+		verdict := pluginVerdict(pl, pl.PreEvictionFilter(pod))
+		if !verdict.Allowed() {
+			ei.recordRejection(frameworktypes.PreEvictionFilterExtensionPoint, pod, verdict)
+			ei.recorder.recordSkip(pod, verdict.String())
+			return false
+		}
+	}
+	return true
+}
+
+// FilterVerdicts runs all the filter plugins and returns their verdicts.
+// Unlike Filter it does not stop at the first rejection.
+func (ei *evictorImpl) FilterVerdicts(pod *v1.Pod) frameworktypes.FilterVerdicts {
+	verdicts := frameworktypes.FilterVerdicts{}
+	for _, pl := range ei.filterPlugins {
+		verdicts = append(verdicts, pluginVerdict(pl, pl.Filter(pod)))
+	}
+	return verdicts
+}
+
+// PreEvictionFilterVerdicts runs all the preEvictionFilter plugins and returns their verdicts.
+// Unlike PreEvictionFilter it does not stop at the first rejection.
+func (ei *evictorImpl) PreEvictionFilterVerdicts(pod *v1.Pod) frameworktypes.FilterVerdicts {
+	verdicts := frameworktypes.FilterVerdicts{}
+	for _, pl := range ei.preEvictionFilterPlugins {
+		verdicts = append(verdicts, pluginVerdict(pl, pl.PreEvictionFilter(pod)))
+	}
+	return verdicts
+}
+
+func (ei *evictorImpl) recordRejection(extensionPoint frameworktypes.ExtensionPoint, pod *v1.Pod, verdict *frameworktypes.FilterVerdict) {
+	klog.V(4).InfoS("Pod rejected by evictor plugin", "pod", klog.KObj(pod), "extensionPoint", extensionPoint, "profile", ei.profileName, "verdict", verdict.String())
+	if ei.filtered == nil || ei.filtered.add(extensionPoint, verdict.PluginName, pod) {
+		for _, reason := range verdict.Reasons {
+			metrics.PodsFiltered.With(map[string]string{"extension_point": string(extensionPoint), "plugin": verdict.PluginName, "reason": string(reason.Code), "profile": ei.profileName}).Inc()
+		}
+	}
+	if ei.span != nil {
+		ei.span.AddEvent("Pod Filtered", trace.WithAttributes(attribute.String("podName", pod.Name), attribute.String("podNamespace", pod.Namespace), attribute.String("extensionPoint", string(extensionPoint)), attribute.String("verdict", verdict.String())))
+	}
+}
+
+// pluginVerdict makes sure a verdict is always returned and carries the name of the plugin.
+// The verdict returned by the plugin is copied as the plugin may keep and reuse it.
+func pluginVerdict(pl frameworktypes.Plugin, verdict *frameworktypes.FilterVerdict) *frameworktypes.FilterVerdict {
+	if verdict == nil {
+		return frameworktypes.NewFilterVerdict(pl.Name())
+	}
+	if verdict.PluginName == "" {
+		named := *verdict
+		named.PluginName = pl.Name()
+		return &named
+	}
+	return verdict
+}
+
+// PreSort sorts pods before they are processed by a plugin
+func (ei *evictorImpl) PreSort(pods []*v1.Pod) {
+	podutil.SortPodsBasedOnLessFuncs(pods, ei.preSort...)
+}
+
+// Sort sorts eviction candidates right before they are evicted
+func (ei *evictorImpl) Sort(pods []*v1.Pod) {
+	podutil.SortPodsBasedOnLessFuncs(pods, ei.sort...)
 }
 
-// Evict evicts a pod (no pre-check performed)
+// Evict evicts a pod (no pre-check performed) and passes the result to the postEviction plugins.
+// The postEviction plugins are not run when the evictions are only simulated.
+// When planning, the pod is proposed to the planner and evicted once the plan gets executed.
 func (ei *evictorImpl) Evict(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
 	opts.ProfileName = ei.profileName
-	return ei.podEvictor.EvictPod(ctx, pod, opts)
+	if ei.planner == nil {
+		err := ei.evict(ctx, pod, opts)
+		ei.recorder.recordEviction(pod, opts, err)
+		return err
+	}
+	recorder := ei.recorder
+	ei.planner.Propose(pod, opts, func(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
+		err := ei.evict(ctx, pod, opts)
+		if recorder != nil {
+			recorder.recordPlanned(pod, opts, err)
+		}
+		return err
+	})
+	return nil
+}
+
+func (ei *evictorImpl) evict(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
+	err := ei.podEvictor.EvictPod(ctx, pod, opts)
+	if ei.podEvictor.DryRun() {
+		return err
+	}
+	for _, pl := range ei.postEvictionPlugins {
+		status := pl.PostEviction(ctx, pod, opts, err)
+		if status != nil && status.Err != nil {
+			klog.ErrorS(status.Err, "postEviction plugin failed", "plugin", pl.Name(), "pod", klog.KObj(pod), "profile", ei.profileName)
+			if ei.span != nil {
+				ei.span.AddEvent("Post Eviction Failed", trace.WithAttributes(attribute.String("plugin", pl.Name()), attribute.String("podName", pod.Name), attribute.String("podNamespace", pod.Namespace), attribute.String("err", status.Err.Error())))
+			}
+		}
+	}
+	return err
 }
 
 // handleImpl implements the framework handle which gets passed to plugins
@@ -73,6 +298,8 @@ type handleImpl struct {
 	metricsCollector          *metricscollector.MetricsCollector
 	getPodsAssignedToNodeFunc podutil.GetPodsAssignedToNodeFunc
 	sharedInformerFactory     informers.SharedInformerFactory
+	dynamicInformerFactory    dynamicinformer.DynamicSharedInformerFactory
+	parallelizer              parallelize.Parallelizer
 	evictor                   *evictorImpl
 }
 
@@ -101,6 +328,16 @@ func (hi *handleImpl) SharedInformerFactory() informers.SharedInformerFactory {
 	return hi.sharedInformerFactory
 }
 
+// DynamicInformerFactory retrieves dynamic shared informer factory
+func (hi *handleImpl) DynamicInformerFactory() dynamicinformer.DynamicSharedInformerFactory {
+	return hi.dynamicInformerFactory
+}
+
+// Parallelizer retrieves the parallelizer plugins run their work pieces with
+func (hi *handleImpl) Parallelizer() parallelize.Parallelizer {
+	return hi.parallelizer
+}
+
 // Evictor retrieves evictor so plugins can filter and evict pods
 func (hi *handleImpl) Evictor() frameworktypes.Evictor {
 	return hi.evictor
@@ -108,28 +345,41 @@ func (hi *handleImpl) Evictor() frameworktypes.Evictor {
 
 type filterPlugin interface {
 	frameworktypes.Plugin
-	Filter(pod *v1.Pod) bool
+	Filter(pod *v1.Pod) *frameworktypes.FilterVerdict
 }
 
 type preEvictionFilterPlugin interface {
 	frameworktypes.Plugin
-	PreEvictionFilter(pod *v1.Pod) bool
+	PreEvictionFilter(pod *v1.Pod) *frameworktypes.FilterVerdict
 }
 
 type profileImpl struct {
 	profileName string
 	podEvictor  *evictions.PodEvictor
 
+	preSortPlugins           []frameworktypes.PreSortPlugin
+	sortPlugins              []frameworktypes.SortPlugin
 	deschedulePlugins        []frameworktypes.DeschedulePlugin
 	balancePlugins           []frameworktypes.BalancePlugin
 	filterPlugins            []filterPlugin
 	preEvictionFilterPlugins []preEvictionFilterPlugin
+	postEvictionPlugins      []frameworktypes.PostEvictionPlugin
+	lifecyclePlugins         []frameworktypes.LifecyclePlugin
+
+	handle  *handleImpl
+	evictor *evictorImpl
 
 	// Each extension point with a list of plugins implementing the extension point.
+	preSort           sets.Set[string]
+	sort              sets.Set[string]
 	deschedule        sets.Set[string]
 	balance           sets.Set[string]
 	filter            sets.Set[string]
 	preEvictionFilter sets.Set[string]
+	postEviction      sets.Set[string]
+
+	// report collects the statuses of the plugins run in the current descheduling cycle
+	report *frameworktypes.CycleReport
 }
 
 // Option for the handleImpl.
@@ -139,9 +389,12 @@ type handleImplOpts struct {
 	clientSet                 clientset.Interface
 	prometheusClient          promapi.Client
 	sharedInformerFactory     informers.SharedInformerFactory
+	dynamicInformerFactory    dynamicinformer.DynamicSharedInformerFactory
 	getPodsAssignedToNodeFunc podutil.GetPodsAssignedToNodeFunc
 	podEvictor                *evictions.PodEvictor
 	metricsCollector          *metricscollector.MetricsCollector
+	parallelizer              parallelize.Parallelizer
+	planner                   *plan.Planner
 }
 
 // WithClientSet sets clientSet for the scheduling frameworkImpl.
@@ -164,6 +417,12 @@ func WithSharedInformerFactory(sharedInformerFactory informers.SharedInformerFac
 	}
 }
 
+func WithDynamicInformerFactory(dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory) Option {
+	return func(o *handleImplOpts) {
+		o.dynamicInformerFactory = dynamicInformerFactory
+	}
+}
+
 func WithPodEvictor(podEvictor *evictions.PodEvictor) Option {
 	return func(o *handleImplOpts) {
 		o.podEvictor = podEvictor
@@ -182,6 +441,21 @@ func WithMetricsCollector(metricsCollector *metricscollector.MetricsCollector) O
 	}
 }
 
+// WithParallelism sets the number of workers plugins run their work pieces with
+func WithParallelism(parallelism int) Option {
+	return func(o *handleImplOpts) {
+		o.parallelizer = parallelize.NewParallelizer(parallelism)
+	}
+}
+
+// WithEvictionPlanner makes the plugins propose their evictions to the planner.
+// The evictions are executed once the planner executes the plan.
+func WithEvictionPlanner(planner *plan.Planner) Option {
+	return func(o *handleImplOpts) {
+		o.planner = planner
+	}
+}
+
 func getPluginConfig(pluginName string, pluginConfigs []api.PluginConfig) (*api.PluginConfig, int) {
 	for idx, pluginConfig := range pluginConfigs {
 		if pluginConfig.Name == pluginName {
@@ -212,12 +486,21 @@ func buildPlugin(config api.DeschedulerProfile, pluginName string, handle *handl
 }
 
 func (p *profileImpl) registryToExtensionPoints(registry pluginregistry.Registry) {
+	p.preSort = sets.New[string]()
+	p.sort = sets.New[string]()
 	p.deschedule = sets.New[string]()
 	p.balance = sets.New[string]()
 	p.filter = sets.New[string]()
 	p.preEvictionFilter = sets.New[string]()
+	p.postEviction = sets.New[string]()
 
 	for plugin, pluginUtilities := range registry {
+		if _, ok := pluginUtilities.PluginType.(frameworktypes.PreSortPlugin); ok {
+			p.preSort.Insert(plugin)
+		}
+		if _, ok := pluginUtilities.PluginType.(frameworktypes.SortPlugin); ok {
+			p.sort.Insert(plugin)
+		}
 		if _, ok := pluginUtilities.PluginType.(frameworktypes.DeschedulePlugin); ok {
 			p.deschedule.Insert(plugin)
 		}
@@ -228,10 +511,13 @@ func (p *profileImpl) registryToExtensionPoints(registry pluginregistry.Registry
 			p.filter.Insert(plugin)
 			p.preEvictionFilter.Insert(plugin)
 		}
+		if _, ok := pluginUtilities.PluginType.(frameworktypes.PostEvictionPlugin); ok {
+			p.postEviction.Insert(plugin)
+		}
 	}
 }
 
-func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts ...Option) (*profileImpl, error) {
+func newHandleImplOpts(opts ...Option) (*handleImplOpts, error) {
 	hOpts := &handleImplOpts{}
 	for _, optFnc := range opts {
 		optFnc(hOpts)
@@ -248,17 +534,35 @@ func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts
 	if hOpts.podEvictor == nil {
 		return nil, fmt.Errorf("podEvictor missing")
 	}
+	return hOpts, nil
+}
+
+func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts ...Option) (*profileImpl, error) {
+	hOpts, err := newHandleImplOpts(opts...)
+	if err != nil {
+		return nil, err
+	}
 
 	pi := &profileImpl{
 		profileName:              config.Name,
 		podEvictor:               hOpts.podEvictor,
+		preSortPlugins:           []frameworktypes.PreSortPlugin{},
+		sortPlugins:              []frameworktypes.SortPlugin{},
 		deschedulePlugins:        []frameworktypes.DeschedulePlugin{},
 		balancePlugins:           []frameworktypes.BalancePlugin{},
 		filterPlugins:            []filterPlugin{},
 		preEvictionFilterPlugins: []preEvictionFilterPlugin{},
+		postEvictionPlugins:      []frameworktypes.PostEvictionPlugin{},
+		lifecyclePlugins:         []frameworktypes.LifecyclePlugin{},
 	}
 	pi.registryToExtensionPoints(reg)
 
+	if !pi.preSort.HasAll(config.Plugins.PreSort.Enabled...) {
+		return nil, fmt.Errorf("profile %q configures preSort extension point of non-existing plugins: %v", config.Name, sets.New(config.Plugins.PreSort.Enabled...).Difference(pi.preSort))
+	}
+	if !pi.sort.HasAll(config.Plugins.Sort.Enabled...) {
+		return nil, fmt.Errorf("profile %q configures sort extension point of non-existing plugins: %v", config.Name, sets.New(config.Plugins.Sort.Enabled...).Difference(pi.sort))
+	}
 	if !pi.deschedule.HasAll(config.Plugins.Deschedule.Enabled...) {
 		return nil, fmt.Errorf("profile %q configures deschedule extension point of non-existing plugins: %v", config.Name, sets.New(config.Plugins.Deschedule.Enabled...).Difference(pi.deschedule))
 	}
@@ -271,25 +575,38 @@ func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts
 	if !pi.preEvictionFilter.HasAll(config.Plugins.PreEvictionFilter.Enabled...) {
 		return nil, fmt.Errorf("profile %q configures preEvictionFilter extension point of non-existing plugins: %v", config.Name, sets.New(config.Plugins.PreEvictionFilter.Enabled...).Difference(pi.preEvictionFilter))
 	}
+	if !pi.postEviction.HasAll(config.Plugins.PostEviction.Enabled...) {
+		return nil, fmt.Errorf("profile %q configures postEviction extension point of non-existing plugins: %v", config.Name, sets.New(config.Plugins.PostEviction.Enabled...).Difference(pi.postEviction))
+	}
 
 	handle := &handleImpl{
 		clientSet:                 hOpts.clientSet,
 		getPodsAssignedToNodeFunc: hOpts.getPodsAssignedToNodeFunc,
 		sharedInformerFactory:     hOpts.sharedInformerFactory,
+		dynamicInformerFactory:    hOpts.dynamicInformerFactory,
+		parallelizer:              hOpts.parallelizer,
 		evictor: &evictorImpl{
 			profileName: config.Name,
 			podEvictor:  hOpts.podEvictor,
+			planner:     hOpts.planner,
+			filtered:    newFilteredPods(),
 		},
 		metricsCollector: hOpts.metricsCollector,
 		prometheusClient: hOpts.prometheusClient,
 	}
 
 	pluginNames := append(config.Plugins.Deschedule.Enabled, config.Plugins.Balance.Enabled...)
+	pluginNames = append(pluginNames, config.Plugins.PreSort.Enabled...)
+	pluginNames = append(pluginNames, config.Plugins.Sort.Enabled...)
 	pluginNames = append(pluginNames, config.Plugins.Filter.Enabled...)
 	pluginNames = append(pluginNames, config.Plugins.PreEvictionFilter.Enabled...)
+	pluginNames = append(pluginNames, config.Plugins.PostEviction.Enabled...)
 
 	plugins := make(map[string]frameworktypes.Plugin)
-	for _, plugin := range sets.New(pluginNames...).UnsortedList() {
+	for _, plugin := range pluginNames {
+		if _, ok := plugins[plugin]; ok {
+			continue
+		}
 		pg, err := buildPlugin(config, plugin, handle, reg)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build %v plugin: %v", plugin, err)
@@ -298,10 +615,24 @@ func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts
 			return nil, fmt.Errorf("got empty %v plugin build", plugin)
 		}
 		plugins[plugin] = pg
+		if lifecyclePlugin, ok := pg.(frameworktypes.LifecyclePlugin); ok {
+			pi.lifecyclePlugins = append(pi.lifecyclePlugins, lifecyclePlugin)
+		}
 	}
 
 	// Later, when a default list of plugins and their extension points is established,
 	// compute the list of enabled extension points as (DefaultEnabled + Enabled - Disabled)
+	// The order of the enabled sort plugins determines their precedence.
+	for _, pluginName := range config.Plugins.PreSort.Enabled {
+		pi.preSortPlugins = append(pi.preSortPlugins, plugins[pluginName].(frameworktypes.PreSortPlugin))
+		handle.evictor.preSort = append(handle.evictor.preSort, plugins[pluginName].(frameworktypes.PreSortPlugin).PreLess)
+	}
+
+	for _, pluginName := range config.Plugins.Sort.Enabled {
+		pi.sortPlugins = append(pi.sortPlugins, plugins[pluginName].(frameworktypes.SortPlugin))
+		handle.evictor.sort = append(handle.evictor.sort, plugins[pluginName].(frameworktypes.SortPlugin).Less)
+	}
+
 	for _, pluginName := range config.Plugins.Deschedule.Enabled {
 		pi.deschedulePlugins = append(pi.deschedulePlugins, plugins[pluginName].(frameworktypes.DeschedulePlugin))
 	}
@@ -310,78 +641,197 @@ func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts
 		pi.balancePlugins = append(pi.balancePlugins, plugins[pluginName].(frameworktypes.BalancePlugin))
 	}
 
-	filters := []podutil.FilterFunc{}
 	for _, pluginName := range config.Plugins.Filter.Enabled {
 		pi.filterPlugins = append(pi.filterPlugins, plugins[pluginName].(filterPlugin))
-		filters = append(filters, plugins[pluginName].(filterPlugin).Filter)
 	}
 
-	preEvictionFilters := []podutil.FilterFunc{}
 	for _, pluginName := range config.Plugins.PreEvictionFilter.Enabled {
 		pi.preEvictionFilterPlugins = append(pi.preEvictionFilterPlugins, plugins[pluginName].(preEvictionFilterPlugin))
-		preEvictionFilters = append(preEvictionFilters, plugins[pluginName].(preEvictionFilterPlugin).PreEvictionFilter)
 	}
 
-	handle.evictor.filter = podutil.WrapFilterFuncs(filters...)
-	handle.evictor.preEvictionFilter = podutil.WrapFilterFuncs(preEvictionFilters...)
+	for _, pluginName := range config.Plugins.PostEviction.Enabled {
+		pi.postEvictionPlugins = append(pi.postEvictionPlugins, plugins[pluginName].(frameworktypes.PostEvictionPlugin))
+	}
+
+	handle.evictor.filterPlugins = pi.filterPlugins
+	handle.evictor.preEvictionFilterPlugins = pi.preEvictionFilterPlugins
+	handle.evictor.postEvictionPlugins = pi.postEvictionPlugins
+	pi.evictor = handle.evictor
+	pi.handle = handle
 
 	return pi, nil
 }
 
-func (d profileImpl) RunDeschedulePlugins(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
-	errs := []error{}
-	for _, pl := range d.deschedulePlugins {
-		var span trace.Span
-		ctx, span = tracing.Tracer().Start(ctx, pl.Name(), trace.WithAttributes(attribute.String("plugin", pl.Name()), attribute.String("profile", d.profileName), attribute.String("operation", tracing.DescheduleOperation)))
-		defer span.End()
-		evictedBeforeDeschedule := d.podEvictor.TotalEvicted()
-		evictionRequestsBeforeDeschedule := d.podEvictor.TotalEvictionRequests()
-		strategyStart := time.Now()
-		status := pl.Deschedule(ctx, nodes)
-		metrics.DeschedulerStrategyDuration.With(map[string]string{"strategy": pl.Name(), "profile": d.profileName}).Observe(time.Since(strategyStart).Seconds())
+// Name returns the name of the profile
+func (d *profileImpl) Name() string {
+	return d.profileName
+}
 
-		if status != nil && status.Err != nil {
-			span.AddEvent("Plugin Execution Failed", trace.WithAttributes(attribute.String("err", status.Err.Error())))
-			errs = append(errs, fmt.Errorf("plugin %q finished with error: %v", pl.Name(), status.Err))
+// UpdateHandle replaces the clients, informers and the pod evictor the plugins
+// access through their handle. Profiles are reused across descheduling cycles
+// while e.g. a dry run works over a new copy of the cluster in every cycle.
+func (d *profileImpl) UpdateHandle(opts ...Option) error {
+	hOpts, err := newHandleImplOpts(opts...)
+	if err != nil {
+		return err
+	}
+
+	d.podEvictor = hOpts.podEvictor
+	d.evictor.podEvictor = hOpts.podEvictor
+	d.evictor.planner = hOpts.planner
+	d.handle.clientSet = hOpts.clientSet
+	d.handle.sharedInformerFactory = hOpts.sharedInformerFactory
+	d.handle.dynamicInformerFactory = hOpts.dynamicInformerFactory
+	d.handle.getPodsAssignedToNodeFunc = hOpts.getPodsAssignedToNodeFunc
+	d.handle.metricsCollector = hOpts.metricsCollector
+	d.handle.prometheusClient = hOpts.prometheusClient
+	d.handle.parallelizer = hOpts.parallelizer
+	return nil
+}
+
+// Init initializes all the plugins implementing the LifecyclePlugin interface.
+// Plugins already initialized are closed when any of the plugins fails to initialize.
+func (d *profileImpl) Init(ctx context.Context) error {
+	for idx, pl := range d.lifecyclePlugins {
+		if err := pl.Init(ctx); err != nil {
+			for _, initialized := range d.lifecyclePlugins[:idx] {
+				if err := initialized.Close(); err != nil {
+					klog.ErrorS(err, "unable to close a plugin", "plugin", initialized.Name(), "profile", d.profileName)
+				}
+			}
+			return fmt.Errorf("unable to initialize %q plugin: %v", pl.Name(), err)
 		}
-		klog.V(1).InfoS("Total number of evictions/requests", "extension point", "Deschedule", "evictedPods", d.podEvictor.TotalEvicted()-evictedBeforeDeschedule, "evictionRequests", d.podEvictor.TotalEvictionRequests()-evictionRequestsBeforeDeschedule)
 	}
+	return nil
+}
 
-	aggrErr := errors.NewAggregate(errs)
-	if aggrErr == nil {
-		return &frameworktypes.Status{}
+// BeforeCycle notifies the plugins implementing the LifecyclePlugin interface a new descheduling cycle starts
+func (d *profileImpl) BeforeCycle(ctx context.Context) error {
+	d.report = &frameworktypes.CycleReport{Profile: d.profileName}
+	d.evictor.filtered = newFilteredPods()
+	errs := []error{}
+	for _, pl := range d.lifecyclePlugins {
+		if err := pl.BeforeCycle(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %q failed to prepare for a cycle: %v", pl.Name(), err))
+		}
 	}
+	return errors.NewAggregate(errs)
+}
 
-	return &frameworktypes.Status{
-		Err: fmt.Errorf("%v", aggrErr.Error()),
+// AfterCycle notifies the plugins implementing the LifecyclePlugin interface the descheduling cycle ended
+func (d *profileImpl) AfterCycle(ctx context.Context) {
+	for _, pl := range d.lifecyclePlugins {
+		pl.AfterCycle(ctx)
 	}
 }
 
-func (d profileImpl) RunBalancePlugins(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+// Close releases resources held by the plugins implementing the LifecyclePlugin interface
+func (d *profileImpl) Close() error {
 	errs := []error{}
-	for _, pl := range d.balancePlugins {
-		var span trace.Span
-		ctx, span = tracing.Tracer().Start(ctx, pl.Name(), trace.WithAttributes(attribute.String("plugin", pl.Name()), attribute.String("profile", d.profileName), attribute.String("operation", tracing.BalanceOperation)))
-		defer span.End()
-		evictedBeforeBalance := d.podEvictor.TotalEvicted()
-		evictionRequestsBeforeBalance := d.podEvictor.TotalEvictionRequests()
-		strategyStart := time.Now()
-		status := pl.Balance(ctx, nodes)
-		metrics.DeschedulerStrategyDuration.With(map[string]string{"strategy": pl.Name(), "profile": d.profileName}).Observe(time.Since(strategyStart).Seconds())
-
-		if status != nil && status.Err != nil {
-			span.AddEvent("Plugin Execution Failed", trace.WithAttributes(attribute.String("err", status.Err.Error())))
-			errs = append(errs, fmt.Errorf("plugin %q finished with error: %v", pl.Name(), status.Err))
+	for _, pl := range d.lifecyclePlugins {
+		if err := pl.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("unable to close %q plugin: %v", pl.Name(), err))
 		}
-		klog.V(1).InfoS("Total number of evictions/requests", "extension point", "Balance", "evictedPods", d.podEvictor.TotalEvicted()-evictedBeforeBalance, "evictionRequests", d.podEvictor.TotalEvictionRequests()-evictionRequestsBeforeBalance)
 	}
+	return errors.NewAggregate(errs)
+}
+
+// Evictor returns the evictor the plugins of the profile evict through
+func (d *profileImpl) Evictor() frameworktypes.Evictor {
+	return d.evictor
+}
 
-	aggrErr := errors.NewAggregate(errs)
-	if aggrErr == nil {
-		return &frameworktypes.Status{}
+// Report returns the statuses of the plugins run since the current descheduling cycle started
+func (d *profileImpl) Report() *frameworktypes.CycleReport {
+	if d.report == nil {
+		d.report = &frameworktypes.CycleReport{Profile: d.profileName}
 	}
+	return d.report
+}
 
-	return &frameworktypes.Status{
-		Err: fmt.Errorf("%v", aggrErr.Error()),
+func (d *profileImpl) RunDeschedulePlugins(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	statuses := []*frameworktypes.Status{}
+	for _, pl := range d.deschedulePlugins {
+		statuses = append(statuses, d.runPlugin(ctx, pl, frameworktypes.DescheduleExtensionPoint, tracing.DescheduleOperation, func(ctx context.Context) *frameworktypes.Status {
+			return pl.Deschedule(ctx, nodes)
+		}))
+	}
+	return aggregateStatuses(statuses)
+}
+
+func (d *profileImpl) RunBalancePlugins(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	statuses := []*frameworktypes.Status{}
+	for _, pl := range d.balancePlugins {
+		statuses = append(statuses, d.runPlugin(ctx, pl, frameworktypes.BalanceExtensionPoint, tracing.BalanceOperation, func(ctx context.Context) *frameworktypes.Status {
+			return pl.Balance(ctx, nodes)
+		}))
+	}
+	return aggregateStatuses(statuses)
+}
+
+// runPlugin runs a deschedule or balance plugin, completes its status
+// with the pods evicted and skipped and adds the status to the report
+func (d *profileImpl) runPlugin(ctx context.Context, pl frameworktypes.Plugin, extensionPoint frameworktypes.ExtensionPoint, operation string, run func(context.Context) *frameworktypes.Status) *frameworktypes.Status {
+	ctx, span := tracing.Tracer().Start(ctx, pl.Name(), trace.WithAttributes(attribute.String("plugin", pl.Name()), attribute.String("profile", d.profileName), attribute.String("operation", operation)))
+	defer span.End()
+	d.evictor.span = span
+	d.evictor.recorder = &statusRecorder{}
+	defer func() {
+		d.evictor.recorder = nil
+	}()
+
+	evictionRequestsBefore := d.evictor.podEvictor.TotalEvictionRequests()
+	strategyStart := time.Now()
+	status := d.evictor.recorder.complete(run(ctx))
+	duration := time.Since(strategyStart)
+	metrics.DeschedulerStrategyDuration.With(map[string]string{"strategy": pl.Name(), "profile": d.profileName}).Observe(duration.Seconds())
+	metrics.PluginRuns.With(map[string]string{"extension_point": string(extensionPoint), "plugin": pl.Name(), "profile": d.profileName, "code": string(status.Code)}).Inc()
+
+	if status.Err != nil {
+		span.AddEvent("Plugin Execution Failed", trace.WithAttributes(attribute.String("err", status.Err.Error())))
+		status.Err = fmt.Errorf("plugin %q finished with error: %v", pl.Name(), status.Err)
+	}
+	klog.V(1).InfoS("Plugin finished", "extension point", extensionPoint, "plugin", pl.Name(), "profile", d.profileName, "code", status.Code, "evictedPods", len(status.Evicted), "skippedPods", len(status.Skipped), "evictionRequests", d.evictor.podEvictor.TotalEvictionRequests()-evictionRequestsBefore)
+
+	report := d.Report()
+	report.Plugins = append(report.Plugins, frameworktypes.PluginReport{
+		Plugin:         pl.Name(),
+		ExtensionPoint: extensionPoint,
+		Duration:       duration,
+		Status:         status,
+	})
+	return status
+}
+
+// statusSeverity orders the status codes from the least to the most severe
+var statusSeverity = map[frameworktypes.StatusCode]int{
+	frameworktypes.Skipped:      0,
+	frameworktypes.Success:      1,
+	frameworktypes.Partial:      2,
+	frameworktypes.LimitReached: 3,
+	frameworktypes.Error:        4,
+}
+
+// aggregateStatuses merges the statuses of plugins run at the same extension point.
+// The code is the most severe of the codes, the node classifications are not merged.
+func aggregateStatuses(statuses []*frameworktypes.Status) *frameworktypes.Status {
+	aggregated := &frameworktypes.Status{Code: frameworktypes.Skipped}
+	if len(statuses) == 0 {
+		return aggregated
+	}
+	errs := []error{}
+	for _, status := range statuses {
+		if status.Err != nil {
+			errs = append(errs, status.Err)
+		}
+		aggregated.Evicted = append(aggregated.Evicted, status.Evicted...)
+		aggregated.Skipped = append(aggregated.Skipped, status.Skipped...)
+		if statusSeverity[status.Code] > statusSeverity[aggregated.Code] {
+			aggregated.Code = status.Code
+		}
+	}
+	if aggrErr := errors.NewAggregate(errs); aggrErr != nil {
+		aggregated.Err = fmt.Errorf("%v", aggrErr.Error())
 	}
+	return aggregated
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -15,12 +16,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
+	"k8s.io/component-base/metrics/testutil"
 
+	"sigs.k8s.io/descheduler/metrics"
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	fakeplugin "sigs.k8s.io/descheduler/pkg/framework/fake/plugin"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsorting"
 	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	testutils "sigs.k8s.io/descheduler/test"
@@ -677,3 +681,1000 @@ func TestProfileExtensionPointOrdering(t *testing.T) {
 		t.Errorf("check for balance invocation order failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestProfileSortExtensionPoints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	lowPriority := int32(100)
+	highPriority := int32(1000)
+	p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, func(pod *v1.Pod) {
+		pod.Spec.Priority = &highPriority
+		pod.CreationTimestamp = metav1.NewTime(metav1.Now().Add(-time.Hour))
+	})
+	p2 := testutils.BuildTestPod("p2", 200, 0, n1.Name, func(pod *v1.Pod) {
+		pod.Spec.Priority = &lowPriority
+		pod.CreationTimestamp = metav1.NewTime(metav1.Now().Add(-2 * time.Hour))
+	})
+	p3 := testutils.BuildTestPod("p3", 200, 0, n1.Name, func(pod *v1.Pod) {
+		pod.Spec.Priority = &lowPriority
+		pod.CreationTimestamp = metav1.NewTime(metav1.Now().Add(-3 * time.Hour))
+	})
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+
+	var preSorted, sorted []string
+	fakePlugin := &fakeplugin.FakeDeschedulePlugin{PluginName: "FakeDeschedulePlugin"}
+	fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+		if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+			pods := []*v1.Pod{p1, p2, p3}
+			dAction.Handle().Evictor().PreSort(pods)
+			for _, pod := range pods {
+				preSorted = append(preSorted, pod.Name)
+			}
+			dAction.Handle().Evictor().Sort(pods)
+			for _, pod := range pods {
+				sorted = append(sorted, pod.Name)
+			}
+			return true, false, nil
+		}
+		return false, false, nil
+	})
+	pluginregistry.Register(
+		fakePlugin.PluginName,
+		fakeplugin.NewFakeDeschedulePluginFncFromFake(fakePlugin),
+		&fakeplugin.FakeDeschedulePlugin{},
+		&fakeplugin.FakeDeschedulePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+	pluginregistry.Register(podsorting.PrioritySortPluginName, podsorting.NewPrioritySort, &podsorting.PrioritySort{}, &podsorting.PrioritySortArgs{}, podsorting.ValidatePrioritySortArgs, podsorting.SetDefaults_PrioritySortArgs, pluginregistry.PluginRegistry)
+	pluginregistry.Register(podsorting.AgeSortPluginName, podsorting.NewAgeSort, &podsorting.AgeSort{}, &podsorting.AgeSortArgs{}, podsorting.ValidateAgeSortArgs, podsorting.SetDefaults_AgeSortArgs, pluginregistry.PluginRegistry)
+	pluginregistry.Register(
+		defaultevictor.PluginName,
+		defaultevictor.New,
+		&defaultevictor.DefaultEvictor{},
+		&defaultevictor.DefaultEvictorArgs{},
+		defaultevictor.ValidateDefaultEvictorArgs,
+		defaultevictor.SetDefaults_DefaultEvictorArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, n2, p1, p2, p3)
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: defaultevictor.PluginName,
+					Args: &defaultevictor.DefaultEvictorArgs{},
+				},
+				{
+					Name: fakePlugin.PluginName,
+					Args: &fakeplugin.FakeDeschedulePluginArgs{},
+				},
+				{
+					Name: podsorting.PrioritySortPluginName,
+					Args: &podsorting.PrioritySortArgs{},
+				},
+				{
+					Name: podsorting.AgeSortPluginName,
+					Args: &podsorting.AgeSortArgs{Order: podsorting.NewestFirst},
+				},
+			},
+			Plugins: api.Plugins{
+				PreSort: api.PluginSet{
+					Enabled: []string{podsorting.AgeSortPluginName},
+				},
+				Sort: api.PluginSet{
+					Enabled: []string{podsorting.PrioritySortPluginName, podsorting.AgeSortPluginName},
+				},
+				Deschedule: api.PluginSet{
+					Enabled: []string{fakePlugin.PluginName},
+				},
+				Filter: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName},
+				},
+				PreEvictionFilter: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	diff := cmp.Diff(sets.New(podsorting.PrioritySortPluginName, podsorting.AgeSortPluginName), prfl.sort)
+	if diff != "" {
+		t.Errorf("check for sort failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
+	}
+
+	prfl.RunDeschedulePlugins(ctx, nodes)
+
+	// newest first
+	diff = cmp.Diff([]string{"p1", "p2", "p3"}, preSorted)
+	if diff != "" {
+		t.Errorf("check for pre-sort order failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
+	}
+
+	// lowest priority first, newest first within the same priority
+	diff = cmp.Diff([]string{"p2", "p3", "p1"}, sorted)
+	if diff != "" {
+		t.Errorf("check for sort order failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestProfileSortExtensionPointNonExistingPlugin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(
+		defaultevictor.PluginName,
+		defaultevictor.New,
+		&defaultevictor.DefaultEvictor{},
+		&defaultevictor.DefaultEvictorArgs{},
+		defaultevictor.ValidateDefaultEvictorArgs,
+		defaultevictor.SetDefaults_DefaultEvictorArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset()
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	_, err = NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: defaultevictor.PluginName,
+					Args: &defaultevictor.DefaultEvictorArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				// DefaultEvictor does not implement the Sort extension point
+				Sort: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err == nil {
+		t.Fatalf("expected an error when enabling sort extension point of a plugin not implementing it")
+	}
+}
+
+func TestProfileFilterVerdicts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	// no owner references, rejected by the DefaultEvictor
+	p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, nil)
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+
+	for _, allow := range []bool{true, false} {
+		pluginName := "Filter_Reject"
+		if allow {
+			pluginName = "Filter_Allow"
+		}
+		fakeFilterPlugin := &fakeplugin.FakeFilterPlugin{PluginName: pluginName}
+		fakeFilterPlugin.AddReactor(string(frameworktypes.FilterExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+			return true, allow, nil
+		})
+		fakeFilterPlugin.AddReactor(string(frameworktypes.PreEvictionFilterExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+			return true, allow, nil
+		})
+		pluginregistry.Register(
+			pluginName,
+			fakeplugin.NewFakeFilterPluginFncFromFake(fakeFilterPlugin),
+			&fakeplugin.FakeFilterPlugin{},
+			&fakeplugin.FakeFilterPluginArgs{},
+			fakeplugin.ValidateFakePluginArgs,
+			fakeplugin.SetDefaults_FakePluginArgs,
+			pluginregistry.PluginRegistry,
+		)
+	}
+
+	pluginregistry.Register(
+		defaultevictor.PluginName,
+		defaultevictor.New,
+		&defaultevictor.DefaultEvictor{},
+		&defaultevictor.DefaultEvictorArgs{},
+		defaultevictor.ValidateDefaultEvictorArgs,
+		defaultevictor.SetDefaults_DefaultEvictorArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, p1)
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: defaultevictor.PluginName,
+					Args: &defaultevictor.DefaultEvictorArgs{},
+				},
+				{
+					Name: "Filter_Allow",
+					Args: &fakeplugin.FakeFilterPluginArgs{},
+				},
+				{
+					Name: "Filter_Reject",
+					Args: &fakeplugin.FakeFilterPluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Filter: api.PluginSet{
+					Enabled: []string{"Filter_Reject", defaultevictor.PluginName, "Filter_Allow"},
+				},
+				PreEvictionFilter: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName, "Filter_Allow"},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if prfl.evictor.Filter(p1) {
+		t.Errorf("Expected the pod to be rejected by the filter plugins")
+	}
+	if !prfl.evictor.PreEvictionFilter(p1) {
+		t.Errorf("Expected the pod to be accepted by the preEvictionFilter plugins")
+	}
+
+	verdicts := prfl.evictor.FilterVerdicts(p1)
+	names := []string{}
+	for _, verdict := range verdicts {
+		names = append(names, verdict.PluginName)
+	}
+	diff := cmp.Diff([]string{"Filter_Reject", defaultevictor.PluginName, "Filter_Allow"}, names)
+	if diff != "" {
+		t.Errorf("check for filter verdicts failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
+	}
+
+	rejected := verdicts.Rejected()
+	if len(rejected) != 2 {
+		t.Fatalf("Expected 2 rejecting verdicts, got %v: %v", len(rejected), rejected)
+	}
+	diff = cmp.Diff([]frameworktypes.FilterReason{{Code: defaultevictor.ReasonNoOwnerReferences, Message: "pod does not have any ownerRefs"}}, rejected[1].Reasons)
+	if diff != "" {
+		t.Errorf("check for filter verdict reasons failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
+	}
+
+	if !prfl.evictor.PreEvictionFilterVerdicts(p1).Allowed() {
+		t.Errorf("Expected the preEvictionFilter verdicts to allow the pod")
+	}
+}
+
+func TestProfileFilterCountedOncePerCycle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	metrics.Register()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, nil)
+	p2 := testutils.BuildTestPod("p2", 200, 0, n1.Name, nil)
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(
+		defaultevictor.PluginName,
+		defaultevictor.New,
+		&defaultevictor.DefaultEvictor{},
+		&defaultevictor.DefaultEvictorArgs{},
+		defaultevictor.ValidateDefaultEvictorArgs,
+		defaultevictor.SetDefaults_DefaultEvictorArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, p1, p2)
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	profileName := "filter-counted-once-profile"
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: profileName,
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: defaultevictor.PluginName,
+					Args: &defaultevictor.DefaultEvictorArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Filter: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	filtered := func() float64 {
+		t.Helper()
+		value, err := testutil.GetCounterMetricValue(metrics.PodsFiltered.With(map[string]string{
+			"extension_point": string(frameworktypes.FilterExtensionPoint),
+			"plugin":          defaultevictor.PluginName,
+			"reason":          string(defaultevictor.ReasonNoOwnerReferences),
+			"profile":         profileName,
+		}))
+		if err != nil {
+			t.Fatalf("Unable to get the pods filtered metric: %v", err)
+		}
+		return value
+	}
+
+	for cycle, expected := range []float64{2, 4} {
+		if err := prfl.BeforeCycle(ctx); err != nil {
+			t.Fatalf("Unexpected error before cycle %v: %v", cycle, err)
+		}
+		// plugins check the same pods repeatedly, e.g. once per node they balance
+		for i := 0; i < 3; i++ {
+			prfl.evictor.Filter(p1)
+			prfl.evictor.Filter(p2)
+		}
+		if got := filtered(); got != expected {
+			t.Errorf("Expected %v pods filtered after cycle %v, got %v", expected, cycle, got)
+		}
+		prfl.AfterCycle(ctx)
+	}
+}
+
+func TestPluginVerdictKeepsPluginVerdict(t *testing.T) {
+	pl := &fakeplugin.FakeFilterPlugin{PluginName: "Filter_Unnamed"}
+	verdict := &frameworktypes.FilterVerdict{Reasons: []frameworktypes.FilterReason{{Code: "Reused"}}}
+
+	named := pluginVerdict(pl, verdict)
+	if named.PluginName != "Filter_Unnamed" {
+		t.Errorf("Expected the verdict to carry the plugin name, got %q", named.PluginName)
+	}
+	if verdict.PluginName != "" {
+		t.Errorf("Expected the verdict returned by the plugin not to be modified, got plugin name %q", verdict.PluginName)
+	}
+}
+
+func TestProfileLifecycle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	tests := []struct {
+		name           string
+		initErr        error
+		beforeCycleErr error
+		expectedCalls  []string
+	}{
+		{
+			name:          "plugin state is kept across cycles",
+			expectedCalls: []string{"New", "Init", "BeforeCycle", "Deschedule", "AfterCycle", "BeforeCycle", "Deschedule", "AfterCycle", "Close"},
+		},
+		{
+			name:          "failing init",
+			initErr:       fmt.Errorf("init failed"),
+			expectedCalls: []string{"New", "Init"},
+		},
+		{
+			name:           "failing before cycle",
+			beforeCycleErr: fmt.Errorf("before cycle failed"),
+			expectedCalls:  []string{"New", "Init", "BeforeCycle", "BeforeCycle", "Close"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeLifecyclePlugin := &fakeplugin.FakeLifecyclePlugin{
+				PluginName:     "FakeLifecyclePlugin",
+				InitErr:        test.initErr,
+				BeforeCycleErr: test.beforeCycleErr,
+			}
+
+			pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+			pluginregistry.Register(
+				fakeLifecyclePlugin.PluginName,
+				fakeplugin.NewFakeLifecyclePluginFncFromFake(fakeLifecyclePlugin),
+				&fakeplugin.FakeLifecyclePlugin{},
+				&fakeplugin.FakeLifecyclePluginArgs{},
+				fakeplugin.ValidateFakePluginArgs,
+				fakeplugin.SetDefaults_FakePluginArgs,
+				pluginregistry.PluginRegistry,
+			)
+
+			client := fakeclientset.NewSimpleClientset(n1, n2)
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				client,
+				nil,
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			prfl, err := NewProfile(
+				api.DeschedulerProfile{
+					Name: "strategy-test-profile",
+					PluginConfigs: []api.PluginConfig{
+						{
+							Name: fakeLifecyclePlugin.PluginName,
+							Args: &fakeplugin.FakeLifecyclePluginArgs{},
+						},
+					},
+					Plugins: api.Plugins{
+						Deschedule: api.PluginSet{
+							Enabled: []string{fakeLifecyclePlugin.PluginName},
+						},
+					},
+				},
+				pluginregistry.PluginRegistry,
+				WithClientSet(client),
+				WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+				WithPodEvictor(podEvictor),
+				WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+			)
+			if err != nil {
+				t.Fatalf("unable to create profile: %v", err)
+			}
+
+			if err := prfl.Init(ctx); err != nil {
+				if test.initErr == nil {
+					t.Fatalf("unexpected init error: %v", err)
+				}
+			} else {
+				for i := 0; i < 2; i++ {
+					if err := prfl.BeforeCycle(ctx); err != nil {
+						if test.beforeCycleErr == nil {
+							t.Fatalf("unexpected before cycle error: %v", err)
+						}
+						continue
+					}
+					prfl.RunDeschedulePlugins(ctx, nodes)
+					prfl.AfterCycle(ctx)
+				}
+				if err := prfl.Close(); err != nil {
+					t.Fatalf("unexpected close error: %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(test.expectedCalls, fakeLifecyclePlugin.Calls); diff != "" {
+				t.Errorf("unexpected plugin invocations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProfileUpdateHandle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	fakeLifecyclePlugin := &fakeplugin.FakeLifecyclePlugin{PluginName: "FakeLifecyclePlugin"}
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(
+		fakeLifecyclePlugin.PluginName,
+		fakeplugin.NewFakeLifecyclePluginFncFromFake(fakeLifecyclePlugin),
+		&fakeplugin.FakeLifecyclePlugin{},
+		&fakeplugin.FakeLifecyclePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset()
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, client, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name:          "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{{Name: fakeLifecyclePlugin.PluginName, Args: &fakeplugin.FakeLifecyclePluginArgs{}}},
+			Plugins:       api.Plugins{Deschedule: api.PluginSet{Enabled: []string{fakeLifecyclePlugin.PluginName}}},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	newClient := fakeclientset.NewSimpleClientset()
+	newHandle, newPodEvictor, err := frameworktesting.InitFrameworkHandle(ctx, newClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	if err := prfl.UpdateHandle(WithClientSet(newClient)); err == nil {
+		t.Errorf("expected an error when updating the handle with missing options")
+	}
+
+	if err := prfl.UpdateHandle(
+		WithClientSet(newClient),
+		WithSharedInformerFactory(newHandle.SharedInformerFactoryImpl),
+		WithPodEvictor(newPodEvictor),
+		WithGetPodsAssignedToNodeFnc(newHandle.GetPodsAssignedToNodeFuncImpl),
+	); err != nil {
+		t.Fatalf("unable to update the handle: %v", err)
+	}
+
+	pluginHandle := prfl.handle
+	if pluginHandle.ClientSet() != newClient {
+		t.Errorf("expected the plugins to see the new client")
+	}
+	if pluginHandle.SharedInformerFactory() != newHandle.SharedInformerFactoryImpl {
+		t.Errorf("expected the plugins to see the new shared informer factory")
+	}
+	if prfl.evictor.podEvictor != newPodEvictor {
+		t.Errorf("expected the evictor to use the new pod evictor")
+	}
+	if diff := cmp.Diff([]string{"New"}, fakeLifecyclePlugin.Calls); diff != "" {
+		t.Errorf("expected the plugin not to be rebuilt (-want +got):\n%s", diff)
+	}
+}
+
+func TestProfilePostEviction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, nil)
+	p1.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+	p2 := testutils.BuildTestPod("p2", 200, 0, n1.Name, nil)
+	p2.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+
+	evictionErr := fmt.Errorf("eviction rejected")
+	var evictionResults []error
+
+	fakePlugin := fakeplugin.FakePlugin{PluginName: "FakePlugin"}
+	fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+		if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+			for _, pod := range []*v1.Pod{p1, p2} {
+				evictionResults = append(evictionResults, dAction.Handle().Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: fakePlugin.PluginName, Reason: "testing"}))
+			}
+			return true, false, nil
+		}
+		return false, false, nil
+	})
+	// a failing postEviction plugin must not affect the others nor the eviction result
+	failingPostEvictionPlugin := &fakeplugin.FakePostEvictionPlugin{PluginName: "FailingPostEviction", Err: fmt.Errorf("audit log unavailable")}
+	postEvictionPlugin := &fakeplugin.FakePostEvictionPlugin{PluginName: "PostEviction"}
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(
+		fakePlugin.PluginName,
+		fakeplugin.NewPluginFncFromFake(&fakePlugin),
+		&fakeplugin.FakePlugin{},
+		&fakeplugin.FakePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+	for _, pl := range []*fakeplugin.FakePostEvictionPlugin{failingPostEvictionPlugin, postEvictionPlugin} {
+		pluginregistry.Register(
+			pl.PluginName,
+			fakeplugin.NewFakePostEvictionPluginFncFromFake(pl),
+			&fakeplugin.FakePostEvictionPlugin{},
+			&fakeplugin.FakePostEvictionPluginArgs{},
+			fakeplugin.ValidateFakePluginArgs,
+			fakeplugin.SetDefaults_FakePluginArgs,
+			pluginregistry.PluginRegistry,
+		)
+	}
+
+	client := fakeclientset.NewSimpleClientset(n1, n2, p1, p2)
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			if eviction, matched := action.(core.CreateActionImpl).Object.(*policy.Eviction); matched && eviction.GetName() == p2.Name {
+				return true, nil, evictionErr
+			}
+		}
+		return false, nil, nil
+	})
+
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: fakePlugin.PluginName,
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+				{
+					Name: failingPostEvictionPlugin.PluginName,
+					Args: &fakeplugin.FakePostEvictionPluginArgs{},
+				},
+				{
+					Name: postEvictionPlugin.PluginName,
+					Args: &fakeplugin.FakePostEvictionPluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{fakePlugin.PluginName},
+				},
+				PostEviction: api.PluginSet{
+					Enabled: []string{failingPostEvictionPlugin.PluginName, postEvictionPlugin.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if status := prfl.RunDeschedulePlugins(ctx, nodes); status.Err != nil {
+		t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+	}
+
+	if len(evictionResults) != 2 || evictionResults[0] != nil || evictionResults[1] == nil {
+		t.Fatalf("Expected the first eviction to succeed and the second one to fail, got %v", evictionResults)
+	}
+
+	for _, pl := range []*fakeplugin.FakePostEvictionPlugin{failingPostEvictionPlugin, postEvictionPlugin} {
+		if len(pl.Evictions) != 2 {
+			t.Fatalf("Expected %q plugin to observe 2 evictions, got %v", pl.PluginName, len(pl.Evictions))
+		}
+		for idx, eviction := range pl.Evictions {
+			if eviction.StrategyName != fakePlugin.PluginName || eviction.ProfileName != "strategy-test-profile" || eviction.Reason != "testing" {
+				t.Errorf("Unexpected eviction options observed by %q plugin: %+v", pl.PluginName, eviction)
+			}
+			if eviction.Err != evictionResults[idx] {
+				t.Errorf("Expected %q plugin to observe %v eviction result, got %v", pl.PluginName, evictionResults[idx], eviction.Err)
+			}
+		}
+		if pl.Evictions[0].Pod != "default/p1" || pl.Evictions[1].Pod != "default/p2" {
+			t.Errorf("Unexpected pods observed by %q plugin: %+v", pl.PluginName, pl.Evictions)
+		}
+	}
+}
+
+func TestProfilePostEvictionDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, nil)
+	p1.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+
+	var evictionErr error
+	fakePlugin := fakeplugin.FakePlugin{PluginName: "FakePlugin"}
+	fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+		if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+			evictionErr = dAction.Handle().Evictor().Evict(ctx, p1, evictions.EvictOptions{StrategyName: fakePlugin.PluginName})
+			return true, false, nil
+		}
+		return false, false, nil
+	})
+	postEvictionPlugin := &fakeplugin.FakePostEvictionPlugin{PluginName: "PostEviction"}
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(
+		fakePlugin.PluginName,
+		fakeplugin.NewPluginFncFromFake(&fakePlugin),
+		&fakeplugin.FakePlugin{},
+		&fakeplugin.FakePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+	pluginregistry.Register(
+		postEvictionPlugin.PluginName,
+		fakeplugin.NewFakePostEvictionPluginFncFromFake(postEvictionPlugin),
+		&fakeplugin.FakePostEvictionPlugin{},
+		&fakeplugin.FakePostEvictionPluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, n2, p1)
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		evictions.NewOptions().WithDryRun(true),
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: fakePlugin.PluginName,
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+				{
+					Name: postEvictionPlugin.PluginName,
+					Args: &fakeplugin.FakePostEvictionPluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{fakePlugin.PluginName},
+				},
+				PostEviction: api.PluginSet{
+					Enabled: []string{postEvictionPlugin.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if status := prfl.RunDeschedulePlugins(ctx, nodes); status.Err != nil {
+		t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+	}
+	if evictionErr != nil {
+		t.Fatalf("Expected the eviction to succeed in the dry run mode, got %v", evictionErr)
+	}
+	if podEvictor.TotalEvicted() != 1 {
+		t.Fatalf("Expected 1 pod evicted in the dry run mode, got %v", podEvictor.TotalEvicted())
+	}
+	if len(postEvictionPlugin.Evictions) != 0 {
+		t.Errorf("Expected no evictions observed by the postEviction plugin in the dry run mode, got %+v", postEvictionPlugin.Evictions)
+	}
+}
+
+func TestProfileReport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, nil)
+	p1.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+	p2 := testutils.BuildTestPod("p2", 200, 0, n1.Name, nil)
+	p2.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+	p3 := testutils.BuildTestPod("p3", 200, 0, n2.Name, nil)
+	p3.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+
+	evict := func(handle frameworktypes.Handle, pods ...*v1.Pod) {
+		for _, pod := range pods {
+			handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: "FakePlugin"})
+		}
+	}
+	fakePlugin := fakeplugin.FakePlugin{PluginName: "FakePlugin"}
+	fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+		if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+			evict(dAction.Handle(), p1, p2)
+			return true, false, nil
+		}
+		return false, false, nil
+	})
+	fakePlugin.AddReactor(string(frameworktypes.BalanceExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+		if bAction, ok := action.(fakeplugin.BalanceAction); ok {
+			evict(bAction.Handle(), p3, p1)
+			return true, false, nil
+		}
+		return false, false, nil
+	})
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(
+		fakePlugin.PluginName,
+		fakeplugin.NewPluginFncFromFake(&fakePlugin),
+		&fakeplugin.FakePlugin{},
+		&fakeplugin.FakePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, n2, p1, p2, p3)
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			if eviction, matched := action.(core.CreateActionImpl).Object.(*policy.Eviction); matched && eviction.GetName() == p2.Name {
+				return true, nil, fmt.Errorf("eviction rejected")
+			}
+		}
+		return false, nil, nil
+	})
+
+	maxPodsToEvictTotal := uint(2)
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		evictions.NewOptions().WithMaxPodsToEvictTotal(&maxPodsToEvictTotal),
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: fakePlugin.PluginName,
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{fakePlugin.PluginName},
+				},
+				Balance: api.PluginSet{
+					Enabled: []string{fakePlugin.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if err := prfl.BeforeCycle(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status := prfl.RunDeschedulePlugins(ctx, nodes); status.Code != frameworktypes.Partial {
+		t.Errorf("Expected %v status code, got %v instead", frameworktypes.Partial, status.Code)
+	}
+	if status := prfl.RunBalancePlugins(ctx, nodes); status.Code != frameworktypes.LimitReached {
+		t.Errorf("Expected %v status code, got %v instead", frameworktypes.LimitReached, status.Code)
+	}
+
+	report := prfl.Report()
+	if report.Evicted() != 2 || report.Skipped() != 2 {
+		t.Errorf("Expected 2 evicted and 2 skipped pods, got %v evicted and %v skipped instead", report.Evicted(), report.Skipped())
+	}
+	type result struct {
+		extensionPoint frameworktypes.ExtensionPoint
+		code           frameworktypes.StatusCode
+		evicted        []string
+		skipped        []string
+	}
+	var results []result
+	for _, plugin := range report.Plugins {
+		r := result{extensionPoint: plugin.ExtensionPoint, code: plugin.Status.Code}
+		for _, pod := range plugin.Status.Evicted {
+			r.evicted = append(r.evicted, pod.Name)
+		}
+		for _, pod := range plugin.Status.Skipped {
+			r.skipped = append(r.skipped, pod.Name+": "+pod.Reason)
+		}
+		results = append(results, r)
+	}
+	expected := []result{
+		{
+			extensionPoint: frameworktypes.DescheduleExtensionPoint,
+			code:           frameworktypes.Partial,
+			evicted:        []string{"p1"},
+			skipped:        []string{"p2: eviction rejected"},
+		},
+		{
+			extensionPoint: frameworktypes.BalanceExtensionPoint,
+			code:           frameworktypes.LimitReached,
+			evicted:        []string{"p3"},
+			skipped:        []string{"p1: maximum number of evicted pods per a descheduling cycle reached"},
+		},
+	}
+	if diff := cmp.Diff(expected, results, cmp.AllowUnexported(result{})); diff != "" {
+		t.Errorf("Unexpected report (-want +got):\n%s", diff)
+	}
+
+	// a new cycle starts with an empty report
+	if err := prfl.BeforeCycle(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if plugins := len(prfl.Report().Plugins); plugins != 0 {
+		t.Errorf("Expected an empty report, got %v plugins instead", plugins)
+	}
+}
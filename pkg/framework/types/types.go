@@ -18,14 +18,21 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/parallelize"
 
 	promapi "github.com/prometheus/client_golang/api"
 )
@@ -40,7 +47,13 @@ type Handle interface {
 	Evictor() Evictor
 	GetPodsAssignedToNodeFunc() podutil.GetPodsAssignedToNodeFunc
 	SharedInformerFactory() informers.SharedInformerFactory
+	// DynamicInformerFactory returns a factory of informers for resources not known to the
+	// kubernetes client set (e.g. custom resources). Nil when no dynamic client is configured.
+	DynamicInformerFactory() dynamicinformer.DynamicSharedInformerFactory
 	MetricsCollector() *metricscollector.MetricsCollector
+	// Parallelizer runs work pieces (e.g. processing of individual nodes) in a bounded pool of workers.
+	// Work pieces running in parallel may evict pods at the same time, eviction limits are shared.
+	Parallelizer() parallelize.Parallelizer
 }
 
 // Evictor defines an interface for filtering and evicting pods
@@ -50,13 +63,111 @@ type Evictor interface {
 	Filter(*v1.Pod) bool
 	// PreEvictionFilter checks if pod can be evicted right before eviction
 	PreEvictionFilter(*v1.Pod) bool
+	// FilterVerdicts runs all the filter plugins and returns their verdicts
+	FilterVerdicts(*v1.Pod) FilterVerdicts
+	// PreEvictionFilterVerdicts runs all the preEvictionFilter plugins and returns their verdicts
+	PreEvictionFilterVerdicts(*v1.Pod) FilterVerdicts
+	// PreSort sorts pods in place before they are processed by a plugin
+	PreSort([]*v1.Pod)
+	// Sort sorts eviction candidates in place right before they are evicted
+	Sort([]*v1.Pod)
 	// Evict evicts a pod (no pre-check performed)
 	Evict(context.Context, *v1.Pod, evictions.EvictOptions) error
 }
 
-// Status describes result of an extension point invocation
+// StatusCode is a short machine readable outcome of an extension point invocation
+type StatusCode string
+
+const (
+	// Success means the plugin finished and all the evictions it attempted succeeded
+	Success StatusCode = "Success"
+	// Skipped means the plugin had nothing to do, e.g. no node was overutilized
+	Skipped StatusCode = "Skipped"
+	// Partial means some of the pods the plugin attempted to evict were not evicted
+	Partial StatusCode = "Partial"
+	// LimitReached means some of the pods were not evicted due to an eviction limit
+	LimitReached StatusCode = "LimitReached"
+	// Error means the plugin failed
+	Error StatusCode = "Error"
+)
+
+// Status describes result of an extension point invocation.
+// Plugins are expected to set Err and optionally Code and NodeClassification,
+// the framework fills in the pods evicted and skipped through the evictor.
 type Status struct {
-	Err error
+	// Code is derived from the rest of the status when not set by the plugin
+	Code StatusCode
+	Err  error
+	// Evicted lists the pods evicted, or with the eviction requested in the background
+	Evicted []PodResult
+	// Skipped lists the pods the plugin attempted to evict and did not, with the reasons
+	Skipped []PodResult
+	// NodeClassification maps a class the plugin assigned to nodes to the node names,
+	// e.g. "underutilized" and "overutilized" nodes of LowNodeUtilization
+	NodeClassification map[string][]string
+}
+
+// PodResult is a pod a plugin attempted to evict
+type PodResult struct {
+	Namespace string
+	Name      string
+	UID       types.UID
+	Node      string
+	// Reason is the eviction reason given by the plugin for the evicted pods,
+	// the reason the pod was not evicted for the skipped pods
+	Reason string
+}
+
+// NewPodResult returns a result of the pod with the given reason
+func NewPodResult(pod *v1.Pod, reason string) PodResult {
+	return PodResult{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		UID:       pod.UID,
+		Node:      pod.Spec.NodeName,
+		Reason:    reason,
+	}
+}
+
+// PluginReport is the status of a plugin run in a descheduling cycle
+type PluginReport struct {
+	Plugin         string
+	ExtensionPoint ExtensionPoint
+	Duration       time.Duration
+	Status         *Status
+}
+
+// CycleReport collects the statuses of all the plugins of a profile run in a descheduling cycle
+type CycleReport struct {
+	Profile string
+	Plugins []PluginReport
+}
+
+// Evicted returns the number of pods evicted by all the plugins
+func (r *CycleReport) Evicted() int {
+	evicted := 0
+	for _, plugin := range r.Plugins {
+		evicted += len(plugin.Status.Evicted)
+	}
+	return evicted
+}
+
+// Skipped returns the number of pods skipped by all the plugins
+func (r *CycleReport) Skipped() int {
+	skipped := 0
+	for _, plugin := range r.Plugins {
+		skipped += len(plugin.Status.Skipped)
+	}
+	return skipped
+}
+
+// Codes returns the number of plugins finished with each of the status codes
+func (r *CycleReport) Codes() map[StatusCode]int {
+	codes := map[StatusCode]int{}
+	for _, plugin := range r.Plugins {
+		codes[plugin.Status.Code]++
+	}
+	return codes
 }
 
 // Plugin is the parent type for all the descheduling framework plugins.
@@ -82,15 +193,155 @@ type BalancePlugin interface {
 // like filtering, sorting, and other ones that might be relevant in the future
 type EvictorPlugin interface {
 	Plugin
-	Filter(pod *v1.Pod) bool
-	PreEvictionFilter(pod *v1.Pod) bool
+	Filter(pod *v1.Pod) *FilterVerdict
+	PreEvictionFilter(pod *v1.Pod) *FilterVerdict
+}
+
+// FilterReasonCode is a short machine readable identifier of a filter reason,
+// e.g. "MirrorPod" or "NoOwnerReferences". Codes are expected to be of
+// a low cardinality as they are exposed through metrics.
+type FilterReasonCode string
+
+// FilterReason describes why a filter rejected a pod
+type FilterReason struct {
+	Code    FilterReasonCode
+	Message string
+}
+
+// FilterVerdict is the result of an evictor plugin filtering a pod.
+// A nil verdict or a verdict with no reasons allows the pod to be evicted.
+type FilterVerdict struct {
+	PluginName string
+	Reasons    []FilterReason
+}
+
+// NewFilterVerdict creates a verdict of the given plugin rejecting a pod
+// for the given reasons. No reasons means the pod is allowed.
+func NewFilterVerdict(pluginName string, reasons ...FilterReason) *FilterVerdict {
+	return &FilterVerdict{
+		PluginName: pluginName,
+		Reasons:    reasons,
+	}
+}
+
+// Allowed reports whether the verdict allows the pod to be evicted
+func (v *FilterVerdict) Allowed() bool {
+	return v == nil || len(v.Reasons) == 0
+}
+
+// String returns a human readable form of the verdict
+func (v *FilterVerdict) String() string {
+	if v.Allowed() {
+		return "allowed"
+	}
+	reasons := make([]string, 0, len(v.Reasons))
+	for _, reason := range v.Reasons {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", reason.Code, reason.Message))
+	}
+	return fmt.Sprintf("%s: [%s]", v.PluginName, strings.Join(reasons, ", "))
+}
+
+// FilterVerdicts is a list of verdicts collected across a filter chain
+type FilterVerdicts []*FilterVerdict
+
+// Allowed reports whether all the verdicts allow the pod to be evicted
+func (vs FilterVerdicts) Allowed() bool {
+	for _, v := range vs {
+		if !v.Allowed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Rejected returns only the verdicts rejecting the pod
+func (vs FilterVerdicts) Rejected() FilterVerdicts {
+	rejected := FilterVerdicts{}
+	for _, v := range vs {
+		if !v.Allowed() {
+			rejected = append(rejected, v)
+		}
+	}
+	return rejected
+}
+
+// String returns a human readable form of the rejecting verdicts
+func (vs FilterVerdicts) String() string {
+	rejected := vs.Rejected()
+	if len(rejected) == 0 {
+		return "allowed"
+	}
+	verdicts := make([]string, 0, len(rejected))
+	for _, v := range rejected {
+		verdicts = append(verdicts, v.String())
+	}
+	return strings.Join(verdicts, "; ")
+}
+
+// PreSortPlugin defines an extension point for sorting pods before they are
+// processed by individual plugins. E.g. to process the oldest pods first.
+type PreSortPlugin interface {
+	Plugin
+	// PreLess reports whether pod1 is to be processed before pod2
+	PreLess(pod1, pod2 *v1.Pod) bool
+}
+
+// SortPlugin defines an extension point for sorting eviction candidates
+// right before they are evicted. E.g. to evict low priority pods first.
+type SortPlugin interface {
+	Plugin
+	// Less reports whether pod1 is to be evicted before pod2
+	Less(pod1, pod2 *v1.Pod) bool
+}
+
+// PostEvictionPlugin defines an extension point invoked after every eviction
+// attempt made through the evictor. E.g. to annotate the owner of the evicted
+// pod, write an audit record or notify an external system. It is not invoked
+// in the dry run mode as no pod gets evicted.
+type PostEvictionPlugin interface {
+	Plugin
+	// PostEviction receives the pod, the eviction options and the error returned
+	// by the eviction (nil when the pod got evicted or its eviction requested).
+	// A failing PostEviction does not change the result of the eviction.
+	PostEviction(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions, evictionErr error) *Status
+}
+
+// ResourcesPlugin is an optional interface for plugins declaring the resources
+// they read through the informer factories of the handle. The descheduler starts
+// and syncs informers of the declared resources only, and mirrors them into the
+// fake client set when running in the dry run mode. Resources unknown to the
+// kubernetes client set (e.g. custom resources) are served by the dynamic informer factory.
+// RequiredResources is invoked on the plugin type registered in the plugin registry
+// before any plugin gets built, so the list can not depend on the plugin arguments.
+type ResourcesPlugin interface {
+	Plugin
+	RequiredResources() []schema.GroupVersionResource
+}
+
+// LifecyclePlugin is an optional interface for plugins keeping state across
+// descheduling cycles. Profiles are built once and reused, so a plugin
+// instance lives for as long as the profile it belongs to.
+type LifecyclePlugin interface {
+	Plugin
+	// Init is invoked once after all the plugins of a profile are built and before the first cycle
+	Init(ctx context.Context) error
+	// BeforeCycle is invoked at the start of every descheduling cycle.
+	// The profile is skipped for the cycle when an error is returned.
+	BeforeCycle(ctx context.Context) error
+	// AfterCycle is invoked at the end of every descheduling cycle the profile ran in
+	AfterCycle(ctx context.Context)
+	// Close is invoked once when the profile is discarded, e.g. on shutdown
+	Close() error
 }
 
 type ExtensionPoint string
 
 const (
+	PreSortExtensionPoint           ExtensionPoint = "PreSort"
+	SortExtensionPoint              ExtensionPoint = "Sort"
 	DescheduleExtensionPoint        ExtensionPoint = "Deschedule"
 	BalanceExtensionPoint           ExtensionPoint = "Balance"
 	FilterExtensionPoint            ExtensionPoint = "Filter"
 	PreEvictionFilterExtensionPoint ExtensionPoint = "PreEvictionFilter"
+	PostEvictionExtensionPoint      ExtensionPoint = "PostEviction"
 )
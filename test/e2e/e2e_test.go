@@ -603,7 +603,7 @@ func TestLowNodeUtilization(t *testing.T) {
 		t.Fatalf("Unable to initialize a framework handle: %v", err)
 	}
 
-	podFilter, err := podutil.NewOptions().WithFilter(handle.EvictorFilterImpl.Filter).BuildFilterFunc()
+	podFilter, err := podutil.NewOptions().WithFilter(handle.Evictor().Filter).BuildFilterFunc()
 	if err != nil {
 		t.Errorf("Error initializing pod filter function, %v", err)
 	}
@@ -630,7 +630,7 @@ func TestLowNodeUtilization(t *testing.T) {
 
 	waitForTerminatingPodsToDisappear(ctx, t, clientSet, rc.Namespace)
 
-	podFilter, err = podutil.NewOptions().WithFilter(handle.EvictorFilterImpl.Filter).BuildFilterFunc()
+	podFilter, err = podutil.NewOptions().WithFilter(handle.Evictor().Filter).BuildFilterFunc()
 	if err != nil {
 		t.Errorf("Error initializing pod filter function, %v", err)
 	}